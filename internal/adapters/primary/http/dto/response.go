@@ -26,11 +26,14 @@ type PaginatedResponse struct {
 	Meta    *PaginationMeta `json:"meta"`
 }
 
+// PaginationMeta describes either an offset page (Page, TotalPages) or a
+// cursor page (NextCursor). Total is optional in cursor mode.
 type PaginationMeta struct {
-	Total      int64 `json:"total"`
-	Page       int   `json:"page"`
-	Limit      int   `json:"limit"`
-	TotalPages int   `json:"total_pages"`
+	Total      *int64 `json:"total,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	Limit      int    `json:"limit"`
+	TotalPages *int   `json:"total_pages,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 func SuccessResponse(c *gin.Context, statusCode int, message string, data interface{}) {
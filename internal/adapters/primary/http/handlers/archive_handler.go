@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/archive"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ArchiveHandler struct {
+	searchUC      *archive.SearchArchivedOrdersUseCase
+	getArchivedUC *archive.GetArchivedOrderUseCase
+	validator     *validator.Validator
+	logger        logger.Logger
+}
+
+func NewArchiveHandler(
+	searchUC *archive.SearchArchivedOrdersUseCase,
+	getArchivedUC *archive.GetArchivedOrderUseCase,
+	validator *validator.Validator,
+	logger logger.Logger,
+) *ArchiveHandler {
+	return &ArchiveHandler{
+		searchUC:      searchUC,
+		getArchivedUC: getArchivedUC,
+		validator:     validator,
+		logger:        logger,
+	}
+}
+
+func (h *ArchiveHandler) SearchArchivedOrders(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	req := dto.SearchArchivedOrdersRequest{
+		Page:            page,
+		Limit:           limit,
+		ClientID:        c.Query("client_id"),
+		TrackingCode:    strings.TrimSpace(c.Query("tracking_code")),
+		OriginCity:      strings.TrimSpace(c.Query("origin_city")),
+		DestinationCity: strings.TrimSpace(c.Query("destination_city")),
+	}
+
+	for _, value := range c.QueryArray("status") {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				req.Statuses = append(req.Statuses, domain.OrderStatus(status))
+			}
+		}
+	}
+
+	timeFilters := map[string]**time.Time{
+		"created_from": &req.CreatedFrom,
+		"created_to":   &req.CreatedTo,
+	}
+	for param, target := range timeFilters {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			httpDto.ValidationErrorResponse(c, param+" must be an RFC3339 timestamp")
+			return
+		}
+		*target = &parsed
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.searchUC.Execute(c.Request.Context(), userID, role, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	total := response.Total
+	totalPages := response.TotalPages
+	meta := &httpDto.PaginationMeta{
+		Total:      &total,
+		Page:       response.Page,
+		Limit:      response.Limit,
+		TotalPages: &totalPages,
+	}
+
+	httpDto.PaginatedSuccessResponse(c, response.Orders, meta)
+}
+
+func (h *ArchiveHandler) GetArchivedOrder(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.getArchivedUC.Execute(c.Request.Context(), orderID, userID, role)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Archived order retrieved successfully", response)
+}
+
+func (h *ArchiveHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
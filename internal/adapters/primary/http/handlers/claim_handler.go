@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/dto"
+	"logistics-api/internal/core/usecases/order"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ClaimHandler struct {
+	fileClaimUC    *order.FileClaimUseCase
+	getClaimsUC    *order.GetOrderClaimsUseCase
+	listClaimsUC   *order.ListClaimsUseCase
+	resolveClaimUC *order.ResolveClaimUseCase
+	validator      *validator.Validator
+	logger         logger.Logger
+}
+
+func NewClaimHandler(
+	fileClaimUC *order.FileClaimUseCase,
+	getClaimsUC *order.GetOrderClaimsUseCase,
+	listClaimsUC *order.ListClaimsUseCase,
+	resolveClaimUC *order.ResolveClaimUseCase,
+	validator *validator.Validator,
+	logger logger.Logger,
+) *ClaimHandler {
+	return &ClaimHandler{
+		fileClaimUC:    fileClaimUC,
+		getClaimsUC:    getClaimsUC,
+		listClaimsUC:   listClaimsUC,
+		resolveClaimUC: resolveClaimUC,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+func (h *ClaimHandler) FileClaim(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.FileClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	clientID := c.GetString("user_id")
+	response, err := h.fileClaimUC.Execute(c.Request.Context(), orderID, clientID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusCreated, "Claim filed successfully", response)
+}
+
+func (h *ClaimHandler) GetOrderClaims(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.getClaimsUC.Execute(c.Request.Context(), orderID, userID, role)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Claims retrieved successfully", response)
+}
+
+func (h *ClaimHandler) ListClaims(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	req := dto.ListClaimsRequest{
+		Page:     page,
+		Limit:    limit,
+		Status:   domain.ClaimStatus(strings.TrimSpace(c.Query("status"))),
+		ClientID: c.Query("client_id"),
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	response, err := h.listClaimsUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	total := response.Total
+	totalPages := response.TotalPages
+	meta := &httpDto.PaginationMeta{
+		Total:      &total,
+		Page:       response.Page,
+		Limit:      response.Limit,
+		TotalPages: &totalPages,
+	}
+
+	httpDto.PaginatedSuccessResponse(c, response.Claims, meta)
+}
+
+func (h *ClaimHandler) ResolveClaim(c *gin.Context) {
+	claimID := c.Param("id")
+	if claimID == "" {
+		httpDto.ValidationErrorResponse(c, "Claim ID is required")
+		return
+	}
+
+	var req dto.ResolveClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	adminID := c.GetString("user_id")
+	response, err := h.resolveClaimUC.Execute(c.Request.Context(), claimID, adminID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Claim resolved successfully", response)
+}
+
+func (h *ClaimHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/dto"
+	"logistics-api/internal/core/usecases/order"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeliveryHandler struct {
+	recordAttemptUC *order.RecordDeliveryAttemptUseCase
+	getAttemptsUC   *order.GetDeliveryAttemptsUseCase
+	parcelStatusUC  *order.UpdateParcelStatusUseCase
+	deliverOrderUC  *order.DeliverOrderUseCase
+	getProofFileUC  *order.GetProofFileUseCase
+	reconcileCashUC *order.ReconcileCashUseCase
+	validator       *validator.Validator
+	logger          logger.Logger
+}
+
+func NewDeliveryHandler(
+	recordAttemptUC *order.RecordDeliveryAttemptUseCase,
+	getAttemptsUC *order.GetDeliveryAttemptsUseCase,
+	parcelStatusUC *order.UpdateParcelStatusUseCase,
+	deliverOrderUC *order.DeliverOrderUseCase,
+	getProofFileUC *order.GetProofFileUseCase,
+	reconcileCashUC *order.ReconcileCashUseCase,
+	validator *validator.Validator,
+	logger logger.Logger,
+) *DeliveryHandler {
+	return &DeliveryHandler{
+		recordAttemptUC: recordAttemptUC,
+		getAttemptsUC:   getAttemptsUC,
+		parcelStatusUC:  parcelStatusUC,
+		deliverOrderUC:  deliverOrderUC,
+		getProofFileUC:  getProofFileUC,
+		reconcileCashUC: reconcileCashUC,
+		validator:       validator,
+		logger:          logger,
+	}
+}
+
+func (h *DeliveryHandler) RecordAttempt(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.RecordDeliveryAttemptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.recordAttemptUC.Execute(c.Request.Context(), orderID, userID, role, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusCreated, "Delivery attempt recorded successfully", response)
+}
+
+func (h *DeliveryHandler) GetAttempts(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.getAttemptsUC.Execute(c.Request.Context(), orderID, userID, role)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Delivery attempts retrieved successfully", response)
+}
+
+func (h *DeliveryHandler) UpdateParcelStatus(c *gin.Context) {
+	orderID := c.Param("id")
+	parcelID := c.Param("parcel_id")
+	if orderID == "" || parcelID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID and parcel ID are required")
+		return
+	}
+
+	var req dto.UpdateParcelStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.parcelStatusUC.Execute(c.Request.Context(), orderID, parcelID, userID, role, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Parcel status updated successfully", response)
+}
+
+// DeliverOrder accepts JSON, or multipart form data when a signature or photo
+// is attached.
+func (h *DeliveryHandler) DeliverOrder(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.DeliverOrderRequest
+	if err := c.ShouldBind(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	signature, closeSignature, err := formProofFile(c, "signature")
+	if err != nil {
+		httpDto.ValidationErrorResponse(c, "Invalid signature upload")
+		return
+	}
+	defer closeSignature()
+
+	photo, closePhoto, err := formProofFile(c, "photo")
+	if err != nil {
+		httpDto.ValidationErrorResponse(c, "Invalid photo upload")
+		return
+	}
+	defer closePhoto()
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.deliverOrderUC.Execute(c.Request.Context(), orderID, userID, role, req, signature, photo)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Order delivered successfully", response)
+}
+
+func (h *DeliveryHandler) GetProofFile(c *gin.Context) {
+	orderID := c.Param("id")
+	kind := domain.ProofFileKind(c.Param("file"))
+	if kind != domain.ProofFileSignature && kind != domain.ProofFilePhoto {
+		httpDto.ValidationErrorResponse(c, "File must be signature or photo")
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	file, contentType, err := h.getProofFileUC.Execute(c.Request.Context(), orderID, userID, role, kind)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	defer file.Close()
+
+	c.Header("Content-Type", contentType)
+	c.Status(http.StatusOK)
+	if _, err := io.Copy(c.Writer, file); err != nil {
+		h.logger.Warn("Failed to stream proof file", logger.Error(err))
+	}
+}
+
+// formProofFile opens an optional multipart file field. The returned close
+// function is always safe to call.
+func formProofFile(c *gin.Context, field string) (*dto.ProofFile, func(), error) {
+	noop := func() {}
+
+	header, err := c.FormFile(field)
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) || errors.Is(err, http.ErrNotMultipart) {
+			return nil, noop, nil
+		}
+		return nil, noop, err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, noop, err
+	}
+
+	return &dto.ProofFile{Content: file, Size: header.Size}, func() { file.Close() }, nil
+}
+
+// ReconcileCash compares the cash each driver collected per day with what the
+// cash-on-delivery orders expected.
+func (h *DeliveryHandler) ReconcileCash(c *gin.Context) {
+	req := dto.CashReconciliationRequest{
+		From:     c.Query("from"),
+		To:       c.Query("to"),
+		DriverID: c.Query("driver_id"),
+		TimeZone: c.Query("time_zone"),
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	response, err := h.reconcileCashUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Cash reconciliation retrieved successfully", response)
+}
+
+func (h *DeliveryHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportWriteWindow is how long each flushed batch extends the response
+// write deadline, so long exports outlive the server's WriteTimeout.
+const exportWriteWindow = time.Minute
+
+// exportSink writes the response headers on first use, so errors raised
+// before any row is read still go out as JSON.
+type exportSink struct {
+	c           *gin.Context
+	contentType string
+	filename    string
+	controller  *http.ResponseController
+}
+
+func newExportSink(c *gin.Context, contentType, extension string) exportSink {
+	return exportSink{
+		c:           c,
+		contentType: contentType,
+		filename:    fmt.Sprintf("orders-%s.%s", time.Now().UTC().Format("20060102-150405"), extension),
+		controller:  http.NewResponseController(c.Writer),
+	}
+}
+
+func (s exportSink) start() {
+	s.c.Header("Content-Type", s.contentType)
+	s.c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", s.filename))
+	s.c.Status(http.StatusOK)
+	s.extendDeadline()
+}
+
+func (s exportSink) extendDeadline() {
+	// Not every writer supports deadlines; the server timeout applies then.
+	_ = s.controller.SetWriteDeadline(time.Now().Add(exportWriteWindow))
+}
+
+// csvVerbatimColumns hold values validated on input that can't carry a
+// formula, such as E.164 phones, so they are written without the quote prefix.
+var csvVerbatimColumns = map[string]bool{
+	"sender_phone":    true,
+	"recipient_phone": true,
+}
+
+type csvExportSink struct {
+	exportSink
+	writer   *csv.Writer
+	verbatim []bool
+}
+
+func newCSVExportSink(c *gin.Context) *csvExportSink {
+	return &csvExportSink{
+		exportSink: newExportSink(c, "text/csv; charset=utf-8", "csv"),
+		writer:     csv.NewWriter(c.Writer),
+	}
+}
+
+func (s *csvExportSink) WriteHeader(columns []string) error {
+	s.verbatim = make([]bool, len(columns))
+	for i, column := range columns {
+		s.verbatim[i] = csvVerbatimColumns[column]
+	}
+
+	s.start()
+	return s.writer.Write(columns)
+}
+
+func (s *csvExportSink) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		if text, ok := value.(string); ok && i < len(s.verbatim) && s.verbatim[i] {
+			record[i] = text
+			continue
+		}
+		record[i] = formatCSVValue(value)
+	}
+	return s.writer.Write(record)
+}
+
+func (s *csvExportSink) Flush() error {
+	s.writer.Flush()
+	if err := s.writer.Error(); err != nil {
+		return err
+	}
+	s.c.Writer.Flush()
+	s.extendDeadline()
+	return nil
+}
+
+// formatCSVValue renders a value for a spreadsheet. Text that starts like a
+// formula is prefixed with a quote so it isn't evaluated when opened.
+func formatCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			return "'" + v
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type ndjsonExportSink struct {
+	exportSink
+	encoder *json.Encoder
+	columns []string
+}
+
+func newNDJSONExportSink(c *gin.Context) *ndjsonExportSink {
+	return &ndjsonExportSink{
+		exportSink: newExportSink(c, "application/x-ndjson", "ndjson"),
+		encoder:    json.NewEncoder(c.Writer),
+	}
+}
+
+func (s *ndjsonExportSink) WriteHeader(columns []string) error {
+	s.start()
+	s.columns = columns
+	return nil
+}
+
+func (s *ndjsonExportSink) WriteRow(values []interface{}) error {
+	row := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		row[s.columns[i]] = value
+	}
+	return s.encoder.Encode(row)
+}
+
+func (s *ndjsonExportSink) Flush() error {
+	s.c.Writer.Flush()
+	s.extendDeadline()
+	return nil
+}
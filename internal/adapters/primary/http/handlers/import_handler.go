@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/dto"
+	"logistics-api/internal/core/usecases/order"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportBodySize caps bulk import uploads.
+const maxImportBodySize = 10 << 20
+
+type ImportHandler struct {
+	importOrdersUC *order.ImportOrdersUseCase
+	getImportJobUC *order.GetImportJobUseCase
+	maxRows        int
+	logger         logger.Logger
+}
+
+func NewImportHandler(
+	importOrdersUC *order.ImportOrdersUseCase,
+	getImportJobUC *order.GetImportJobUseCase,
+	maxRows int,
+	logger logger.Logger,
+) *ImportHandler {
+	return &ImportHandler{
+		importOrdersUC: importOrdersUC,
+		getImportJobUC: getImportJobUC,
+		maxRows:        maxRows,
+		logger:         logger,
+	}
+}
+
+// ImportOrders accepts a CSV or JSON body, or either as the "file" field of a
+// multipart form.
+func (h *ImportHandler) ImportOrders(c *gin.Context) {
+	clientID := c.GetString("user_id")
+	if clientID == "" {
+		h.logger.Error("Client ID not found in context")
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBodySize)
+
+	rows, err := h.parseImport(c)
+	if err != nil {
+		h.logger.Warn("Invalid import file", logger.Error(err))
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			httpDto.ErrorResponse(c, http.StatusRequestEntityTooLarge, "validation_error", "Import file is too large")
+			return
+		}
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	response, err := h.importOrdersUC.Execute(c.Request.Context(), clientID, rows)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.StatusURL = "/api/v1/orders/imports/" + response.ID
+	if response.Status == domain.ImportJobPending {
+		c.Header("Location", response.StatusURL)
+		httpDto.SuccessResponse(c, http.StatusAccepted, "Import queued", response)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Import completed", response)
+}
+
+func (h *ImportHandler) GetImportJob(c *gin.Context) {
+	jobID := c.Param("job_id")
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.getImportJobUC.Execute(c.Request.Context(), jobID, userID, role)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.StatusURL = "/api/v1/orders/imports/" + response.ID
+	httpDto.SuccessResponse(c, http.StatusOK, "Import job retrieved successfully", response)
+}
+
+func (h *ImportHandler) parseImport(c *gin.Context) ([]dto.ImportOrderRow, error) {
+	switch c.ContentType() {
+	case "text/csv", "application/csv":
+		return dto.ParseImportCSV(c.Request.Body, h.maxRows)
+	case "application/json":
+		return dto.ParseImportJSON(c.Request.Body, h.maxRows)
+	case "multipart/form-data":
+		return h.parseImportFile(c)
+	default:
+		return nil, errors.New("unsupported content type; send text/csv, application/json or a multipart file")
+	}
+}
+
+func (h *ImportHandler) parseImportFile(c *gin.Context) ([]dto.ImportOrderRow, error) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) {
+			return nil, errors.New("file is required")
+		}
+		return nil, err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(header.Filename)) {
+	case ".csv":
+		return dto.ParseImportCSV(file, h.maxRows)
+	case ".json":
+		return dto.ParseImportJSON(file, h.maxRows)
+	default:
+		return nil, errors.New("file must be .csv or .json")
+	}
+}
+
+func (h *ImportHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/dto"
+	"logistics-api/internal/core/usecases/order"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NoteHandler struct {
+	addNoteUC  *order.AddOrderNoteUseCase
+	getNotesUC *order.GetOrderNotesUseCase
+	validator  *validator.Validator
+	logger     logger.Logger
+}
+
+func NewNoteHandler(
+	addNoteUC *order.AddOrderNoteUseCase,
+	getNotesUC *order.GetOrderNotesUseCase,
+	validator *validator.Validator,
+	logger logger.Logger,
+) *NoteHandler {
+	return &NoteHandler{
+		addNoteUC:  addNoteUC,
+		getNotesUC: getNotesUC,
+		validator:  validator,
+		logger:     logger,
+	}
+}
+
+func (h *NoteHandler) AddNote(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.AddOrderNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.addNoteUC.Execute(c.Request.Context(), orderID, userID, role, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusCreated, "Note added successfully", response)
+}
+
+func (h *NoteHandler) GetNotes(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.getNotesUC.Execute(c.Request.Context(), orderID, userID, role)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Notes retrieved successfully", response)
+}
+
+func (h *NoteHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	httpDto "logistics-api/internal/adapters/primary/http/dto"
 	"logistics-api/internal/core/domain"
@@ -19,6 +22,14 @@ type OrderHandler struct {
 	createOrderUC  *order.CreateOrderUseCase
 	getOrdersUC    *order.GetOrdersUseCase
 	updateStatusUC *order.UpdateOrderStatusUseCase
+	getOrderUC     *order.GetOrderByIDUseCase
+	getHistoryUC   *order.GetOrderHistoryUseCase
+	cancelOrderUC  *order.CancelOrderUseCase
+	updateOrderUC  *order.UpdateOrderUseCase
+	batchStatusUC  *order.BatchUpdateStatusUseCase
+	exportOrdersUC *order.ExportOrdersUseCase
+	deleteOrderUC  *order.DeleteOrderUseCase
+	restoreOrderUC *order.RestoreOrderUseCase
 	validator      *validator.Validator
 	logger         logger.Logger
 }
@@ -27,6 +38,14 @@ func NewOrderHandler(
 	createOrderUC *order.CreateOrderUseCase,
 	getOrdersUC *order.GetOrdersUseCase,
 	updateStatusUC *order.UpdateOrderStatusUseCase,
+	getOrderUC *order.GetOrderByIDUseCase,
+	getHistoryUC *order.GetOrderHistoryUseCase,
+	cancelOrderUC *order.CancelOrderUseCase,
+	updateOrderUC *order.UpdateOrderUseCase,
+	batchStatusUC *order.BatchUpdateStatusUseCase,
+	exportOrdersUC *order.ExportOrdersUseCase,
+	deleteOrderUC *order.DeleteOrderUseCase,
+	restoreOrderUC *order.RestoreOrderUseCase,
 	validator *validator.Validator,
 	logger logger.Logger,
 ) *OrderHandler {
@@ -34,6 +53,14 @@ func NewOrderHandler(
 		createOrderUC:  createOrderUC,
 		getOrdersUC:    getOrdersUC,
 		updateStatusUC: updateStatusUC,
+		getOrderUC:     getOrderUC,
+		getHistoryUC:   getHistoryUC,
+		cancelOrderUC:  cancelOrderUC,
+		updateOrderUC:  updateOrderUC,
+		batchStatusUC:  batchStatusUC,
+		exportOrdersUC: exportOrdersUC,
+		deleteOrderUC:  deleteOrderUC,
+		restoreOrderUC: restoreOrderUC,
 		validator:      validator,
 		logger:         logger,
 	}
@@ -71,18 +98,8 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 }
 
 func (h *OrderHandler) GetOrders(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	status := c.Query("status")
-
-	listReq := dto.ListOrdersRequest{
-		Page:   page,
-		Limit:  limit,
-		Status: domain.OrderStatus(status),
-	}
-
-	if err := h.validator.Validate(listReq); err != nil {
-		httpDto.ValidationErrorResponse(c, err.Error())
+	listReq, ok := h.bindListOrdersRequest(c)
+	if !ok {
 		return
 	}
 
@@ -103,11 +120,142 @@ func (h *OrderHandler) GetOrders(c *gin.Context) {
 		return
 	}
 
+	writeOrderList(c, response)
+}
+
+func (h *OrderHandler) GetOverdueOrders(c *gin.Context) {
+	listReq, ok := h.bindListOrdersRequest(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.getOrdersUC.ExecuteOverdue(c.Request.Context(), listReq)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	writeOrderList(c, response)
+}
+
+// ExportOrders streams the orders matching the listing filters as CSV or
+// NDJSON. Once rows are flowing an error can only cut the response short.
+func (h *OrderHandler) ExportOrders(c *gin.Context) {
+	filters, ok := h.bindListOrdersRequest(c)
+	if !ok {
+		return
+	}
+
+	req := dto.ExportOrdersRequest{
+		Filters:  filters,
+		Format:   c.DefaultQuery("format", "csv"),
+		TimeZone: c.Query("time_zone"),
+	}
+	if columns := c.Query("columns"); columns != "" {
+		for _, column := range strings.Split(columns, ",") {
+			req.Columns = append(req.Columns, strings.TrimSpace(column))
+		}
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	var sink dto.OrderExportSink
+	if req.Format == "ndjson" {
+		sink = newNDJSONExportSink(c)
+	} else {
+		sink = newCSVExportSink(c)
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	if err := h.exportOrdersUC.Execute(c.Request.Context(), userID, role, req, sink); err != nil {
+		if c.Writer.Written() {
+			h.logger.Error("Order export interrupted", logger.Error(err))
+			c.Abort()
+			return
+		}
+		h.handleError(c, err)
+	}
+}
+
+// bindListOrdersRequest reads pagination and filters from the query string,
+// writing a validation error response when they are invalid.
+func (h *OrderHandler) bindListOrdersRequest(c *gin.Context) (dto.ListOrdersRequest, bool) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	listReq := dto.ListOrdersRequest{
+		Page:                 page,
+		Limit:                limit,
+		PackageSize:          domain.PackageSize(c.Query("package_size")),
+		ClientID:             c.Query("client_id"),
+		OriginCity:           strings.TrimSpace(c.Query("origin_city")),
+		DestinationCity:      strings.TrimSpace(c.Query("destination_city")),
+		OriginZipPrefix:      strings.TrimSpace(c.Query("origin_zip_prefix")),
+		DestinationZipPrefix: strings.TrimSpace(c.Query("destination_zip_prefix")),
+		SortBy:               c.Query("sort_by"),
+		SortOrder:            strings.ToLower(c.Query("sort_order")),
+		Deleted:              c.Query("deleted") == "true",
+		Pagination:           c.Query("pagination"),
+		Cursor:               c.Query("cursor"),
+		IncludeTotal:         c.Query("include_total") == "true",
+	}
+
+	// status may be repeated or comma-separated.
+	for _, value := range c.QueryArray("status") {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				listReq.Statuses = append(listReq.Statuses, domain.OrderStatus(status))
+			}
+		}
+	}
+
+	timeFilters := map[string]**time.Time{
+		"created_from":  &listReq.CreatedFrom,
+		"created_to":    &listReq.CreatedTo,
+		"pickup_from":   &listReq.PickupFrom,
+		"pickup_to":     &listReq.PickupTo,
+		"delivery_from": &listReq.DeliveryFrom,
+		"delivery_to":   &listReq.DeliveryTo,
+	}
+	for param, target := range timeFilters {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			httpDto.ValidationErrorResponse(c, param+" must be an RFC3339 timestamp")
+			return listReq, false
+		}
+		*target = &parsed
+	}
+
+	if err := h.validator.Validate(listReq); err != nil {
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return listReq, false
+	}
+
+	return listReq, true
+}
+
+func writeOrderList(c *gin.Context, response *dto.ListOrdersResponse) {
 	meta := &httpDto.PaginationMeta{
 		Total:      response.Total,
 		Page:       response.Page,
 		Limit:      response.Limit,
 		TotalPages: response.TotalPages,
+		NextCursor: response.NextCursor,
 	}
 
 	httpDto.PaginatedSuccessResponse(c, response.Orders, meta)
@@ -120,7 +268,66 @@ func (h *OrderHandler) GetOrderByID(c *gin.Context) {
 		return
 	}
 
-	httpDto.ErrorResponse(c, http.StatusNotImplemented, "not_implemented", "Feature not implemented yet")
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.getOrderUC.Execute(c.Request.Context(), orderID, userID, role)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	setETag(c, response.Version)
+	httpDto.SuccessResponse(c, http.StatusOK, "Order retrieved successfully", response)
+}
+
+func (h *OrderHandler) UpdateOrder(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	expectedVersion, ok := parseIfMatch(c.GetHeader("If-Match"))
+	if !ok {
+		httpDto.ErrorResponse(c, http.StatusPreconditionRequired, "precondition_required", "If-Match header with the order ETag is required")
+		return
+	}
+
+	var req dto.UpdateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.updateOrderUC.Execute(c.Request.Context(), orderID, userID, role, expectedVersion, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	setETag(c, response.Version)
+	httpDto.SuccessResponse(c, http.StatusOK, "Order updated successfully", response)
 }
 
 func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
@@ -150,7 +357,8 @@ func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
 	}
 
 	role := userRole.(domain.UserRole)
-	response, err := h.updateStatusUC.Execute(c.Request.Context(), orderID, role, req)
+	userID := c.GetString("user_id")
+	response, err := h.updateStatusUC.Execute(c.Request.Context(), orderID, userID, role, req)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -159,6 +367,157 @@ func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
 	httpDto.SuccessResponse(c, http.StatusOK, "Order status updated successfully", response)
 }
 
+func (h *OrderHandler) BatchUpdateStatus(c *gin.Context) {
+	var req dto.BatchUpdateStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response := h.batchStatusUC.Execute(c.Request.Context(), userID, role, req)
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Batch status update processed", response)
+}
+
+func (h *OrderHandler) GetOrderHistory(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.getHistoryUC.Execute(c.Request.Context(), orderID, userID, role)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Order history retrieved successfully", response)
+}
+
+func (h *OrderHandler) CancelOrder(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.CancelOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	clientID := c.GetString("user_id")
+	if clientID == "" {
+		h.logger.Error("Client ID not found in context")
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	response, err := h.cancelOrderUC.Execute(c.Request.Context(), orderID, clientID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Order cancelled successfully", response)
+}
+
+func (h *OrderHandler) DeleteOrder(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.DeleteOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	adminID := c.GetString("user_id")
+	response, err := h.deleteOrderUC.Execute(c.Request.Context(), orderID, adminID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Order deleted successfully", response)
+}
+
+func (h *OrderHandler) RestoreOrder(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	adminID := c.GetString("user_id")
+	response, err := h.restoreOrderUC.Execute(c.Request.Context(), orderID, adminID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Order restored successfully", response)
+}
+
+// setETag exposes the order version so clients can send it back in If-Match.
+func setETag(c *gin.Context, version int) {
+	c.Header("ETag", fmt.Sprintf("%q", strconv.Itoa(version)))
+}
+
+// parseIfMatch reads the order version from an If-Match header. Both strong
+// ("3") and weak (W/"3") forms are accepted.
+func parseIfMatch(header string) (int, bool) {
+	value := strings.TrimPrefix(strings.TrimSpace(header), "W/")
+	value = strings.Trim(value, `"`)
+	version, err := strconv.Atoi(value)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
+
 func (h *OrderHandler) handleError(c *gin.Context, err error) {
 	if appErr, ok := err.(*appErrors.AppError); ok {
 		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/usecases/dto"
+	"logistics-api/internal/core/usecases/pricing"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PricingHandler struct {
+	getQuoteUC *pricing.GetQuoteUseCase
+	validator  *validator.Validator
+	logger     logger.Logger
+}
+
+func NewPricingHandler(
+	getQuoteUC *pricing.GetQuoteUseCase,
+	validator *validator.Validator,
+	logger logger.Logger,
+) *PricingHandler {
+	return &PricingHandler{
+		getQuoteUC: getQuoteUC,
+		validator:  validator,
+		logger:     logger,
+	}
+}
+
+func (h *PricingHandler) GetQuote(c *gin.Context) {
+	var req dto.QuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	response, err := h.getQuoteUC.Execute(c.Request.Context(), c.GetString("user_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Quote calculated successfully", response)
+}
+
+func (h *PricingHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
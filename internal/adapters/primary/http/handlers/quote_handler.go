@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"net/http"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/dto"
+	"logistics-api/internal/core/usecases/order"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type QuoteHandler struct {
+	attachQuoteUC    *order.AttachQuoteUseCase
+	respondToQuoteUC *order.RespondToQuoteUseCase
+	validator        *validator.Validator
+	logger           logger.Logger
+}
+
+func NewQuoteHandler(
+	attachQuoteUC *order.AttachQuoteUseCase,
+	respondToQuoteUC *order.RespondToQuoteUseCase,
+	validator *validator.Validator,
+	logger logger.Logger,
+) *QuoteHandler {
+	return &QuoteHandler{
+		attachQuoteUC:    attachQuoteUC,
+		respondToQuoteUC: respondToQuoteUC,
+		validator:        validator,
+		logger:           logger,
+	}
+}
+
+func (h *QuoteHandler) AttachQuote(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.AttachQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	role := userRole.(domain.UserRole)
+	userID := c.GetString("user_id")
+	response, err := h.attachQuoteUC.Execute(c.Request.Context(), orderID, userID, role, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Quote attached successfully", response)
+}
+
+func (h *QuoteHandler) AcceptQuote(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	clientID := c.GetString("user_id")
+	if clientID == "" {
+		h.logger.Error("Client ID not found in context")
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	response, err := h.respondToQuoteUC.Accept(c.Request.Context(), orderID, clientID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Quote accepted successfully", response)
+}
+
+func (h *QuoteHandler) RejectQuote(c *gin.Context) {
+	orderID := c.Param("id")
+	if orderID == "" {
+		httpDto.ValidationErrorResponse(c, "Order ID is required")
+		return
+	}
+
+	var req dto.RejectQuoteRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Warn("Invalid request format", logger.Error(err))
+			httpDto.ValidationErrorResponse(c, "Invalid request format")
+			return
+		}
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	clientID := c.GetString("user_id")
+	if clientID == "" {
+		h.logger.Error("Client ID not found in context")
+		httpDto.UnauthorizedResponse(c)
+		return
+	}
+
+	response, err := h.respondToQuoteUC.Reject(c.Request.Context(), orderID, clientID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Quote rejected successfully", response)
+}
+
+func (h *QuoteHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
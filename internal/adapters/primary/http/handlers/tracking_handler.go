@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/usecases/tracking"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TrackingHandler struct {
+	trackOrderUC *tracking.TrackOrderUseCase
+	logger       logger.Logger
+}
+
+func NewTrackingHandler(
+	trackOrderUC *tracking.TrackOrderUseCase,
+	logger logger.Logger,
+) *TrackingHandler {
+	return &TrackingHandler{
+		trackOrderUC: trackOrderUC,
+		logger:       logger,
+	}
+}
+
+func (h *TrackingHandler) Track(c *gin.Context) {
+	response, err := h.trackOrderUC.Execute(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Shipment retrieved successfully", response)
+}
+
+func (h *TrackingHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
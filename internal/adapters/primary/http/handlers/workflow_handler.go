@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+
+	httpDto "logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/dto"
+	"logistics-api/internal/core/usecases/workflow"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkflowHandler struct {
+	listWorkflowsUC *workflow.ListWorkflowsUseCase
+	saveWorkflowUC  *workflow.SaveWorkflowUseCase
+	validator       *validator.Validator
+	logger          logger.Logger
+}
+
+func NewWorkflowHandler(
+	listWorkflowsUC *workflow.ListWorkflowsUseCase,
+	saveWorkflowUC *workflow.SaveWorkflowUseCase,
+	validator *validator.Validator,
+	logger logger.Logger,
+) *WorkflowHandler {
+	return &WorkflowHandler{
+		listWorkflowsUC: listWorkflowsUC,
+		saveWorkflowUC:  saveWorkflowUC,
+		validator:       validator,
+		logger:          logger,
+	}
+}
+
+func (h *WorkflowHandler) ListWorkflows(c *gin.Context) {
+	response, err := h.listWorkflowsUC.Execute(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Workflows retrieved successfully", response)
+}
+
+func (h *WorkflowHandler) SaveWorkflow(c *gin.Context) {
+	serviceType := domain.ServiceType(c.Param("service_type"))
+	if !domain.IsValidServiceType(serviceType) {
+		httpDto.ValidationErrorResponse(c, "Invalid service type")
+		return
+	}
+
+	var req dto.SaveWorkflowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("Invalid request format", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, "Invalid request format")
+		return
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		h.logger.Warn("Validation failed", logger.Error(err))
+		httpDto.ValidationErrorResponse(c, err.Error())
+		return
+	}
+
+	response, err := h.saveWorkflowUC.Execute(c.Request.Context(), serviceType, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	httpDto.SuccessResponse(c, http.StatusOK, "Workflow saved successfully", response)
+}
+
+func (h *WorkflowHandler) handleError(c *gin.Context, err error) {
+	if appErr, ok := err.(*appErrors.AppError); ok {
+		httpDto.ErrorResponse(c, appErr.Code, appErr.Type, appErr.Message)
+		return
+	}
+
+	h.logger.Error("Unexpected error", logger.Error(err))
+	httpDto.InternalErrorResponse(c)
+}
@@ -0,0 +1,243 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"logistics-api/internal/adapters/primary/http/dto"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyMaxRequestSize = 1 << 20
+)
+
+// Idempotency replays the stored response when a client retries a request
+// with the same Idempotency-Key. It must run after RequireAuth, since keys
+// are scoped to the authenticated user.
+type Idempotency struct {
+	repo        repositories.IdempotencyKeyRepository
+	ttl         time.Duration
+	lockTimeout time.Duration
+	logger      logger.Logger
+}
+
+// NewIdempotency keeps completed responses for ttl. A key left in progress
+// for longer than lockTimeout, e.g. by a crashed instance, can be retried.
+func NewIdempotency(repo repositories.IdempotencyKeyRepository, ttl, lockTimeout time.Duration, logger logger.Logger) *Idempotency {
+	return &Idempotency{
+		repo:        repo,
+		ttl:         ttl,
+		lockTimeout: lockTimeout,
+		logger:      logger,
+	}
+}
+
+func (m *Idempotency) Handle() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := strings.TrimSpace(c.GetHeader(IdempotencyKeyHeader))
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, idempotencyMaxRequestSize+1))
+		if err != nil || len(body) > idempotencyMaxRequestSize {
+			dto.ValidationErrorResponse(c, "Invalid request body")
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		clientID := c.GetString("user_id")
+		record, err := domain.NewIdempotencyKey(clientID, key, requestHash(c, body), time.Now(), m.ttl, m.lockTimeout)
+		if err != nil {
+			dto.ValidationErrorResponse(c, err.Error())
+			c.Abort()
+			return
+		}
+
+		// Writes after the handler must survive a client that hung up, or the
+		// key would stay in progress and block its own retry.
+		ctx := context.WithoutCancel(c.Request.Context())
+
+		existing, err := m.claim(ctx, record)
+		if err != nil {
+			if errors.Is(err, domain.ErrIdempotencyKeyInUse) {
+				m.respondInProgress(c)
+				return
+			}
+			m.logger.Error("Failed to claim idempotency key", logger.Error(err))
+			dto.InternalErrorResponse(c)
+			c.Abort()
+			return
+		}
+
+		if existing != nil {
+			m.replay(c, existing, record.RequestHash)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		defer func() {
+			if r := recover(); r != nil {
+				m.release(ctx, record)
+				panic(r)
+			}
+		}()
+
+		c.Next()
+
+		// Server errors are not remembered so the client can retry them.
+		if recorder.Status() >= http.StatusInternalServerError {
+			m.release(ctx, record)
+			return
+		}
+
+		record.Complete(recorder.Status(), recorder.body.Bytes(), time.Now())
+		if err := m.repo.Update(ctx, record); err != nil {
+			if errors.Is(err, domain.ErrIdempotencyKeyLost) {
+				m.logger.Warn("Idempotency key was taken over before the response was stored",
+					logger.String("client_id", clientID),
+				)
+				return
+			}
+			m.logger.Error("Failed to store idempotent response",
+				logger.String("client_id", clientID),
+				logger.Error(err),
+			)
+		}
+	}
+}
+
+// StartCleanup periodically removes expired keys.
+func (m *Idempotency) StartCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			count, err := m.repo.DeleteExpired(context.Background(), time.Now())
+			if err != nil {
+				m.logger.Error("Failed to delete expired idempotency keys", logger.Error(err))
+				continue
+			}
+			if count > 0 {
+				m.logger.Info("Deleted expired idempotency keys", logger.Int("count", int(count)))
+			}
+		}
+	}()
+}
+
+// claim stores the key for this request. When the client already holds the
+// key it returns the stored record instead; an expired one, or one whose lock
+// timed out, is replaced. The delete is conditional so that a concurrent
+// retry that already replaced it keeps its claim.
+func (m *Idempotency) claim(ctx context.Context, record *domain.IdempotencyKey) (*domain.IdempotencyKey, error) {
+	for attempt := 0; attempt < 3; attempt++ {
+		err := m.repo.Create(ctx, record)
+		if !errors.Is(err, domain.ErrIdempotencyKeyInUse) {
+			return nil, err
+		}
+
+		existing, err := m.repo.Get(ctx, record.ClientID, record.Key)
+		if err != nil {
+			continue
+		}
+
+		if !existing.IsReclaimable(record.CreatedAt) {
+			return existing, nil
+		}
+
+		// Nothing deleted means another retry replaced the key first; the next
+		// attempt finds its record.
+		deleted, err := m.repo.DeleteReclaimable(ctx, record.ClientID, record.Key, record.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if deleted && !existing.IsExpired(record.CreatedAt) {
+			m.logger.Warn("Taking over idempotency key with a timed-out lock",
+				logger.String("client_id", record.ClientID),
+			)
+		}
+	}
+
+	return nil, domain.ErrIdempotencyKeyInUse
+}
+
+func (m *Idempotency) replay(c *gin.Context, existing *domain.IdempotencyKey, requestHash string) {
+	if !existing.Matches(requestHash) {
+		m.logger.Warn("Idempotency key reused with a different request",
+			logger.String("client_id", existing.ClientID),
+		)
+		dto.ErrorResponse(c, http.StatusUnprocessableEntity, "idempotency_error",
+			"Idempotency-Key was already used with a different request")
+		c.Abort()
+		return
+	}
+
+	if !existing.IsCompleted() {
+		m.respondInProgress(c)
+		return
+	}
+
+	m.logger.Info("Replaying idempotent response", logger.String("client_id", existing.ClientID))
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(existing.StatusCode, "application/json; charset=utf-8", existing.ResponseBody)
+	c.Abort()
+}
+
+func (m *Idempotency) respondInProgress(c *gin.Context) {
+	dto.ErrorResponse(c, http.StatusConflict, "conflict_error",
+		"A request with this Idempotency-Key is still being processed")
+	c.Abort()
+}
+
+func (m *Idempotency) release(ctx context.Context, record *domain.IdempotencyKey) {
+	if err := m.repo.Delete(ctx, record); err != nil {
+		if errors.Is(err, domain.ErrIdempotencyKeyLost) {
+			m.logger.Warn("Idempotency key was taken over before it was released",
+				logger.String("client_id", record.ClientID),
+			)
+			return
+		}
+		m.logger.Error("Failed to release idempotency key", logger.Error(err))
+	}
+}
+
+// requestHash fingerprints the route and raw body, so the same key can't be
+// reused for a different request.
+func requestHash(c *gin.Context, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(c.Request.Method + " " + c.FullPath() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
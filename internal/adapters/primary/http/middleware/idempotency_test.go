@@ -0,0 +1,237 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestIdempotencyReplaysCompletedResponse(t *testing.T) {
+	repo := newMemoryIdempotencyRepo()
+	router, calls := idempotencyRouter(repo, http.StatusCreated)
+
+	first := sendIdempotent(router, "key-1", `{"a":1}`)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first status = %d, want %d", first.Code, http.StatusCreated)
+	}
+
+	retry := sendIdempotent(router, "key-1", `{"a":1}`)
+	if retry.Code != http.StatusCreated {
+		t.Errorf("retry status = %d, want %d", retry.Code, http.StatusCreated)
+	}
+	if retry.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Errorf("retry is missing the %s header", IdempotentReplayedHeader)
+	}
+	if retry.Body.String() != first.Body.String() {
+		t.Errorf("retry body = %q, want %q", retry.Body.String(), first.Body.String())
+	}
+	if *calls != 1 {
+		t.Errorf("handler ran %d times, want 1", *calls)
+	}
+}
+
+func TestIdempotencyRejectsKeyReuseWithDifferentBody(t *testing.T) {
+	repo := newMemoryIdempotencyRepo()
+	router, calls := idempotencyRouter(repo, http.StatusCreated)
+
+	sendIdempotent(router, "key-1", `{"a":1}`)
+	retry := sendIdempotent(router, "key-1", `{"a":2}`)
+
+	if retry.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", retry.Code, http.StatusUnprocessableEntity)
+	}
+	if *calls != 1 {
+		t.Errorf("handler ran %d times, want 1", *calls)
+	}
+}
+
+func TestIdempotencyInProgressKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		lockedFor  time.Duration
+		wantStatus int
+		wantCalls  int
+	}{
+		{name: "lock held", lockedFor: time.Minute, wantStatus: http.StatusConflict, wantCalls: 0},
+		{name: "lock timed out", lockedFor: -time.Minute, wantStatus: http.StatusCreated, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryIdempotencyRepo()
+			router, calls := idempotencyRouter(repo, http.StatusCreated)
+
+			// Claim the key with the same request, as a stuck first attempt would.
+			sendIdempotent(router, "key-1", `{"a":1}`)
+			stored := repo.keys["user-1/key-1"]
+			stored.CompletedAt = nil
+			stored.StatusCode = 0
+			stored.LockedUntil = time.Now().Add(tt.lockedFor)
+			*calls = 0
+
+			w := sendIdempotent(router, "key-1", `{"a":1}`)
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("handler ran %d times, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIdempotencyReleasesKeyOnServerError(t *testing.T) {
+	repo := newMemoryIdempotencyRepo()
+	router, calls := idempotencyRouter(repo, http.StatusInternalServerError)
+
+	sendIdempotent(router, "key-1", `{"a":1}`)
+	if _, ok := repo.keys["user-1/key-1"]; ok {
+		t.Fatal("key was kept after a server error")
+	}
+
+	sendIdempotent(router, "key-1", `{"a":1}`)
+	if *calls != 2 {
+		t.Errorf("handler ran %d times, want 2", *calls)
+	}
+}
+
+func TestIdempotencyDoesNotOverwriteTakenOverKey(t *testing.T) {
+	repo := newMemoryIdempotencyRepo()
+	takeover := &domain.IdempotencyKey{
+		ClientID:  "user-1",
+		Key:       "key-1",
+		CreatedAt: time.Now().Add(time.Second),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("user_id", "user-1") })
+	router.POST("/orders", NewIdempotency(repo, time.Hour, time.Minute, nopLogger{}).Handle(), func(c *gin.Context) {
+		// Another request takes the key over while this one is still running.
+		repo.keys["user-1/key-1"] = takeover
+		c.JSON(http.StatusCreated, gin.H{"ok": true})
+	})
+
+	sendIdempotent(router, "key-1", `{"a":1}`)
+
+	if stored := repo.keys["user-1/key-1"]; stored != takeover || stored.IsCompleted() {
+		t.Error("the response overwrote the key held by another request")
+	}
+}
+
+func idempotencyRouter(repo *memoryIdempotencyRepo, status int) (*gin.Engine, *int) {
+	gin.SetMode(gin.TestMode)
+	calls := 0
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set("user_id", "user-1") })
+	router.POST("/orders", NewIdempotency(repo, time.Hour, time.Minute, nopLogger{}).Handle(), func(c *gin.Context) {
+		calls++
+		c.JSON(status, gin.H{"call": calls})
+	})
+	return router, &calls
+}
+
+func sendIdempotent(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+	req.Header.Set(IdempotencyKeyHeader, key)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// memoryIdempotencyRepo mirrors the conditional writes of the postgres
+// repository.
+type memoryIdempotencyRepo struct {
+	keys map[string]*domain.IdempotencyKey
+}
+
+func newMemoryIdempotencyRepo() *memoryIdempotencyRepo {
+	return &memoryIdempotencyRepo{keys: make(map[string]*domain.IdempotencyKey)}
+}
+
+func (r *memoryIdempotencyRepo) Create(ctx context.Context, key *domain.IdempotencyKey) error {
+	id := key.ClientID + "/" + key.Key
+	if _, ok := r.keys[id]; ok {
+		return domain.ErrIdempotencyKeyInUse
+	}
+	stored := *key
+	r.keys[id] = &stored
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) Get(ctx context.Context, clientID, key string) (*domain.IdempotencyKey, error) {
+	stored, ok := r.keys[clientID+"/"+key]
+	if !ok {
+		return nil, errors.New("idempotency key not found")
+	}
+	copied := *stored
+	return &copied, nil
+}
+
+func (r *memoryIdempotencyRepo) Update(ctx context.Context, key *domain.IdempotencyKey) error {
+	stored, ok := r.claimed(key)
+	if !ok {
+		return domain.ErrIdempotencyKeyLost
+	}
+	stored.StatusCode = key.StatusCode
+	stored.ResponseBody = key.ResponseBody
+	stored.CompletedAt = key.CompletedAt
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) Delete(ctx context.Context, key *domain.IdempotencyKey) error {
+	if _, ok := r.claimed(key); !ok {
+		return domain.ErrIdempotencyKeyLost
+	}
+	delete(r.keys, key.ClientID+"/"+key.Key)
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) DeleteReclaimable(ctx context.Context, clientID, key string, now time.Time) (bool, error) {
+	id := clientID + "/" + key
+	stored, ok := r.keys[id]
+	if !ok || !stored.IsReclaimable(now) {
+		return false, nil
+	}
+	delete(r.keys, id)
+	return true, nil
+}
+
+func (r *memoryIdempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	var count int64
+	for id, stored := range r.keys {
+		if stored.IsExpired(now) {
+			delete(r.keys, id)
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryIdempotencyRepo) claimed(key *domain.IdempotencyKey) (*domain.IdempotencyKey, bool) {
+	stored, ok := r.keys[key.ClientID+"/"+key.Key]
+	if !ok || !stored.CreatedAt.Equal(key.CreatedAt) || stored.IsCompleted() {
+		return nil, false
+	}
+	return stored, true
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...logger.Field)        {}
+func (nopLogger) Info(string, ...logger.Field)         {}
+func (nopLogger) Warn(string, ...logger.Field)         {}
+func (nopLogger) Error(string, ...logger.Field)        {}
+func (nopLogger) Fatal(string, ...logger.Field)        {}
+func (l nopLogger) With(...logger.Field) logger.Logger { return l }
@@ -12,22 +12,46 @@ import (
 )
 
 type Router struct {
-	engine         *gin.Engine
-	authHandler    *handlers.AuthHandler
-	orderHandler   *handlers.OrderHandler
-	healthHandler  *health.HealthHandler
-	authMiddleware *middleware.AuthMiddleware
-	logger         logger.Logger
+	engine          *gin.Engine
+	authHandler     *handlers.AuthHandler
+	orderHandler    *handlers.OrderHandler
+	deliveryHandler *handlers.DeliveryHandler
+	noteHandler     *handlers.NoteHandler
+	claimHandler    *handlers.ClaimHandler
+	importHandler   *handlers.ImportHandler
+	quoteHandler    *handlers.QuoteHandler
+	pricingHandler  *handlers.PricingHandler
+	workflowHandler *handlers.WorkflowHandler
+	trackingHandler *handlers.TrackingHandler
+	archiveHandler  *handlers.ArchiveHandler
+	healthHandler   *health.HealthHandler
+	authMiddleware  *middleware.AuthMiddleware
+	idempotency     *middleware.Idempotency
+	trackingLimiter *middleware.RateLimiter
+	logger          logger.Logger
 }
 
 type RouterConfig struct {
-	AuthHandler    *handlers.AuthHandler
-	OrderHandler   *handlers.OrderHandler
-	HealthHandler  *health.HealthHandler
-	AuthMiddleware *middleware.AuthMiddleware
-	Logger         logger.Logger
-	RateLimitRPS   float64
-	RateLimitBurst int
+	AuthHandler     *handlers.AuthHandler
+	OrderHandler    *handlers.OrderHandler
+	DeliveryHandler *handlers.DeliveryHandler
+	NoteHandler     *handlers.NoteHandler
+	ClaimHandler    *handlers.ClaimHandler
+	ImportHandler   *handlers.ImportHandler
+	QuoteHandler    *handlers.QuoteHandler
+	PricingHandler  *handlers.PricingHandler
+	WorkflowHandler *handlers.WorkflowHandler
+	TrackingHandler *handlers.TrackingHandler
+	ArchiveHandler  *handlers.ArchiveHandler
+	HealthHandler   *health.HealthHandler
+	AuthMiddleware  *middleware.AuthMiddleware
+	Idempotency     *middleware.Idempotency
+	Logger          logger.Logger
+	RateLimitRPS    float64
+	RateLimitBurst  int
+
+	TrackingRateLimitRPS   float64
+	TrackingRateLimitBurst int
 }
 
 func NewRouter(config RouterConfig) *Router {
@@ -50,13 +74,31 @@ func NewRouter(config RouterConfig) *Router {
 
 	rateLimiter.StartCleanup(time.Minute * 5)
 
+	trackingLimiter := middleware.NewRateLimiter(
+		rate.Limit(config.TrackingRateLimitRPS),
+		config.TrackingRateLimitBurst,
+		config.Logger,
+	)
+	trackingLimiter.StartCleanup(time.Minute * 5)
+
 	return &Router{
-		engine:         engine,
-		authHandler:    config.AuthHandler,
-		orderHandler:   config.OrderHandler,
-		healthHandler:  config.HealthHandler,
-		authMiddleware: config.AuthMiddleware,
-		logger:         config.Logger,
+		engine:          engine,
+		authHandler:     config.AuthHandler,
+		orderHandler:    config.OrderHandler,
+		deliveryHandler: config.DeliveryHandler,
+		noteHandler:     config.NoteHandler,
+		claimHandler:    config.ClaimHandler,
+		importHandler:   config.ImportHandler,
+		quoteHandler:    config.QuoteHandler,
+		pricingHandler:  config.PricingHandler,
+		workflowHandler: config.WorkflowHandler,
+		trackingHandler: config.TrackingHandler,
+		archiveHandler:  config.ArchiveHandler,
+		healthHandler:   config.HealthHandler,
+		authMiddleware:  config.AuthMiddleware,
+		idempotency:     config.Idempotency,
+		trackingLimiter: trackingLimiter,
+		logger:          config.Logger,
 	}
 }
 
@@ -76,23 +118,53 @@ func (r *Router) SetupRoutes() {
 		auth.POST("/login", r.authHandler.Login)
 	}
 
+	api.GET("/track/:code", r.trackingLimiter.RateLimit(), r.trackingHandler.Track)
+
 	protected := api.Group("/")
 	protected.Use(r.authMiddleware.RequireAuth())
 	{
 		orders := protected.Group("/orders")
 		{
-			orders.POST("/", r.orderHandler.CreateOrder)
+			orders.POST("/", r.idempotency.Handle(), r.orderHandler.CreateOrder)
 			orders.GET("/", r.orderHandler.GetOrders)
+			orders.GET("/export", r.orderHandler.ExportOrders)
+			orders.POST("/imports", r.importHandler.ImportOrders)
+			orders.GET("/imports/:job_id", r.importHandler.GetImportJob)
+			orders.GET("/archive", r.archiveHandler.SearchArchivedOrders)
+			orders.GET("/archive/:id", r.archiveHandler.GetArchivedOrder)
 			orders.GET("/:id", r.orderHandler.GetOrderByID)
+			orders.PATCH("/:id", r.orderHandler.UpdateOrder)
+			orders.GET("/:id/history", r.orderHandler.GetOrderHistory)
+			orders.GET("/:id/notes", r.noteHandler.GetNotes)
+			orders.POST("/:id/notes", r.noteHandler.AddNote)
+			orders.GET("/:id/claims", r.claimHandler.GetOrderClaims)
+			orders.POST("/:id/claims", r.authMiddleware.RequireClient(), r.claimHandler.FileClaim)
+			orders.POST("/:id/quote", r.authMiddleware.RequireAdmin(), r.quoteHandler.AttachQuote)
+			orders.POST("/:id/quote/accept", r.authMiddleware.RequireClient(), r.quoteHandler.AcceptQuote)
+			orders.POST("/:id/quote/reject", r.authMiddleware.RequireClient(), r.quoteHandler.RejectQuote)
+			orders.PUT("/:id/parcels/:parcel_id/status", r.authMiddleware.RequireAdmin(), r.deliveryHandler.UpdateParcelStatus)
+			orders.POST("/:id/deliver", r.authMiddleware.RequireAdmin(), r.deliveryHandler.DeliverOrder)
+			orders.GET("/:id/proof-of-delivery/:file", r.deliveryHandler.GetProofFile)
+			orders.GET("/:id/delivery-attempts", r.deliveryHandler.GetAttempts)
+			orders.POST("/:id/delivery-attempts", r.authMiddleware.RequireAdmin(), r.deliveryHandler.RecordAttempt)
 			orders.PUT("/:id/status", r.authMiddleware.RequireAdmin(), r.orderHandler.UpdateOrderStatus)
+			orders.POST("/:id/cancel", r.authMiddleware.RequireClient(), r.orderHandler.CancelOrder)
 		}
 
+		protected.POST("/quotes", r.pricingHandler.GetQuote)
+
 		admin := protected.Group("/admin")
 		admin.Use(r.authMiddleware.RequireAdmin())
 		{
-			// Future admin endpoints can go here
-			// admin.GET("/users", r.userHandler.GetAllUsers)
-			// admin.GET("/analytics", r.analyticsHandler.GetAnalytics)
+			admin.GET("/orders/overdue", r.orderHandler.GetOverdueOrders)
+			admin.POST("/orders/status", r.orderHandler.BatchUpdateStatus)
+			admin.GET("/cash-reconciliation", r.deliveryHandler.ReconcileCash)
+			admin.DELETE("/orders/:id", r.orderHandler.DeleteOrder)
+			admin.POST("/orders/:id/restore", r.orderHandler.RestoreOrder)
+			admin.GET("/claims", r.claimHandler.ListClaims)
+			admin.PUT("/claims/:id", r.claimHandler.ResolveClaim)
+			admin.GET("/workflows", r.workflowHandler.ListWorkflows)
+			admin.PUT("/workflows/:service_type", r.workflowHandler.SaveWorkflow)
 		}
 	}
 
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+
+	"gorm.io/gorm"
+)
+
+type ArchivedOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewArchivedOrderRepository(db *gorm.DB) *ArchivedOrderRepository {
+	return &ArchivedOrderRepository{db: db}
+}
+
+func (r *ArchivedOrderRepository) Create(ctx context.Context, order *domain.ArchivedOrder) error {
+	return dbFromContext(ctx, r.db).Create(order).Error
+}
+
+func (r *ArchivedOrderRepository) GetByID(ctx context.Context, id string) (*domain.ArchivedOrder, error) {
+	var order domain.ArchivedOrder
+	err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("archived order not found")
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// Search leaves out the snapshot, which is only needed for a single order.
+func (r *ArchivedOrderRepository) Search(ctx context.Context, filter repositories.ArchivedOrderFilter, limit, offset int) ([]*domain.ArchivedOrder, error) {
+	var orders []*domain.ArchivedOrder
+	err := applyArchivedOrderFilter(dbFromContext(ctx, r.db), filter).
+		Omit("snapshot").
+		Order("order_created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&orders).Error
+	return orders, err
+}
+
+func (r *ArchivedOrderRepository) Count(ctx context.Context, filter repositories.ArchivedOrderFilter) (int64, error) {
+	var count int64
+	err := applyArchivedOrderFilter(dbFromContext(ctx, r.db).Model(&domain.ArchivedOrder{}), filter).
+		Count(&count).Error
+	return count, err
+}
+
+func applyArchivedOrderFilter(db *gorm.DB, filter repositories.ArchivedOrderFilter) *gorm.DB {
+	if !filter.IncludeDeleted {
+		db = db.Where("deleted_at IS NULL")
+	}
+	if filter.ClientID != "" {
+		db = db.Where("client_id = ?", filter.ClientID)
+	}
+	if filter.TrackingCode != "" {
+		db = db.Where("tracking_code = ?", filter.TrackingCode)
+	}
+	if len(filter.Statuses) > 0 {
+		db = db.Where("status IN ?", filter.Statuses)
+	}
+	if filter.CreatedFrom != nil {
+		db = db.Where("order_created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		db = db.Where("order_created_at <= ?", *filter.CreatedTo)
+	}
+	if filter.OriginCity != "" {
+		db = db.Where("LOWER(origin_city) = LOWER(?)", filter.OriginCity)
+	}
+	if filter.DestinationCity != "" {
+		db = db.Where("LOWER(destination_city) = LOWER(?)", filter.DestinationCity)
+	}
+	return db
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const uniqueViolation = "23505"
+
+type ClaimRepository struct {
+	db *gorm.DB
+}
+
+func NewClaimRepository(db *gorm.DB) *ClaimRepository {
+	return &ClaimRepository{db: db}
+}
+
+// Create relies on idx_claims_open_order to reject a second open claim filed
+// concurrently for the same order.
+func (r *ClaimRepository) Create(ctx context.Context, claim *domain.Claim) error {
+	err := dbFromContext(ctx, r.db).Create(claim).Error
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "idx_claims_open_order" {
+		return domain.ErrClaimAlreadyOpen
+	}
+	return err
+}
+
+func (r *ClaimRepository) GetByID(ctx context.Context, id string) (*domain.Claim, error) {
+	var claim domain.Claim
+	err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&claim).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("claim not found")
+		}
+		return nil, err
+	}
+	return &claim, nil
+}
+
+// Update only writes claims that are still under review, so two admins
+// resolving the same claim can't overwrite each other's decision.
+func (r *ClaimRepository) Update(ctx context.Context, claim *domain.Claim) error {
+	result := dbFromContext(ctx, r.db).Model(claim).
+		Select("*").
+		Where("status = ?", domain.ClaimSubmitted).
+		Updates(claim)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrClaimAlreadyResolved
+	}
+	return nil
+}
+
+func (r *ClaimRepository) GetByOrderID(ctx context.Context, orderID string) ([]*domain.Claim, error) {
+	var claims []*domain.Claim
+	err := dbFromContext(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&claims).Error
+	return claims, err
+}
+
+func (r *ClaimRepository) List(ctx context.Context, filter repositories.ClaimFilter, limit, offset int) ([]*domain.Claim, error) {
+	var claims []*domain.Claim
+	err := applyClaimFilter(dbFromContext(ctx, r.db), filter).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&claims).Error
+	return claims, err
+}
+
+func (r *ClaimRepository) Count(ctx context.Context, filter repositories.ClaimFilter) (int64, error) {
+	var count int64
+	err := applyClaimFilter(dbFromContext(ctx, r.db).Model(&domain.Claim{}), filter).
+		Count(&count).Error
+	return count, err
+}
+
+func applyClaimFilter(db *gorm.DB, filter repositories.ClaimFilter) *gorm.DB {
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.ClientID != "" {
+		db = db.Where("client_id = ?", filter.ClientID)
+	}
+	return db
+}
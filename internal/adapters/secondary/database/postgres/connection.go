@@ -40,8 +40,105 @@ func NewConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
 }
 
 func autoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	err := db.AutoMigrate(
 		&domain.User{},
 		&domain.Order{},
+		&domain.Parcel{},
+		&domain.OrderStatusEvent{},
+		&domain.DeliveryAttempt{},
+		&domain.ProofOfDelivery{},
+		&domain.ImportJob{},
+		&domain.IdempotencyKey{},
+		&domain.ArchivedOrder{},
+		&domain.OrderNote{},
+		&domain.Claim{},
+		&domain.ShipmentQuote{},
+		&workflowDefinition{},
 	)
+	if err != nil {
+		return err
+	}
+
+	if err := backfillTrackingCodes(db); err != nil {
+		return err
+	}
+	if err := backfillParcelTrackingCodes(db); err != nil {
+		return err
+	}
+	return backfillClosedAt(db)
+}
+
+// backfillParcelTrackingCodes replaces the random codes parcels used to get
+// with ones derived from their order's code.
+func backfillParcelTrackingCodes(db *gorm.DB) error {
+	var parcels []struct {
+		ID        string
+		Sequence  int
+		OrderCode string
+	}
+	err := db.Table("parcels").
+		Select("parcels.id, parcels.sequence, orders.tracking_code AS order_code").
+		Joins("JOIN orders ON orders.id = parcels.order_id").
+		Where("parcels.tracking_code NOT LIKE orders.tracking_code || '%'").
+		Scan(&parcels).Error
+	if err != nil {
+		return err
+	}
+
+	for _, parcel := range parcels {
+		code, err := domain.ParcelTrackingCode(parcel.OrderCode, parcel.Sequence)
+		if err != nil {
+			return fmt.Errorf("failed to backfill tracking code for parcel %s: %w", parcel.ID, err)
+		}
+		err = db.Model(&domain.Parcel{}).
+			Where("id = ?", parcel.ID).
+			UpdateColumn("tracking_code", code).Error
+		if err != nil {
+			return fmt.Errorf("failed to backfill tracking code for parcel %s: %w", parcel.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// backfillClosedAt dates orders closed before closed_at existed from the
+// event that closed them, falling back to their last update.
+func backfillClosedAt(db *gorm.DB) error {
+	return db.Exec(`
+		UPDATE orders SET closed_at = COALESCE((
+			SELECT MAX(e.created_at) FROM order_status_events e
+			WHERE e.order_id = orders.id
+				AND e.to_status = orders.status
+				AND e.from_status <> e.to_status
+		), orders.updated_at)
+		WHERE closed_at IS NULL AND status IN ?`,
+		domain.GetArchivableStatuses(),
+	).Error
+}
+
+// backfillTrackingCodes gives orders created before tracking codes existed a
+// code of their own.
+func backfillTrackingCodes(db *gorm.DB) error {
+	var ids []string
+	err := db.Model(&domain.Order{}).
+		Where("tracking_code IS NULL OR tracking_code = ''").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		code, err := domain.GenerateTrackingCode()
+		if err != nil {
+			return err
+		}
+		err = db.Model(&domain.Order{}).
+			Where("id = ?", id).
+			UpdateColumn("tracking_code", code).Error
+		if err != nil {
+			return fmt.Errorf("failed to backfill tracking code for order %s: %w", id, err)
+		}
+	}
+
+	return nil
 }
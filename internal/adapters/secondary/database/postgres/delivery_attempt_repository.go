@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+)
+
+type DeliveryAttemptRepository struct {
+	db *gorm.DB
+}
+
+func NewDeliveryAttemptRepository(db *gorm.DB) *DeliveryAttemptRepository {
+	return &DeliveryAttemptRepository{db: db}
+}
+
+func (r *DeliveryAttemptRepository) Create(ctx context.Context, attempt *domain.DeliveryAttempt) error {
+	return dbFromContext(ctx, r.db).Create(attempt).Error
+}
+
+func (r *DeliveryAttemptRepository) GetByOrderID(ctx context.Context, orderID string) ([]*domain.DeliveryAttempt, error) {
+	var attempts []*domain.DeliveryAttempt
+	err := dbFromContext(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("attempt_number ASC").
+		Find(&attempts).Error
+	return attempts, err
+}
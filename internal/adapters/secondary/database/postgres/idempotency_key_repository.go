@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyRepository(db *gorm.DB) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{db: db}
+}
+
+func (r *IdempotencyKeyRepository) Create(ctx context.Context, key *domain.IdempotencyKey) error {
+	result := dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(key)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrIdempotencyKeyInUse
+	}
+	return nil
+}
+
+func (r *IdempotencyKeyRepository) Get(ctx context.Context, clientID, key string) (*domain.IdempotencyKey, error) {
+	var record domain.IdempotencyKey
+	err := dbFromContext(ctx, r.db).
+		Where("client_id = ? AND key = ?", clientID, key).
+		First(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("idempotency key not found")
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *IdempotencyKeyRepository) Update(ctx context.Context, key *domain.IdempotencyKey) error {
+	result := claimedKey(dbFromContext(ctx, r.db), key).
+		Select("status_code", "response_body", "completed_at").
+		Updates(key)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrIdempotencyKeyLost
+	}
+	return nil
+}
+
+func (r *IdempotencyKeyRepository) Delete(ctx context.Context, key *domain.IdempotencyKey) error {
+	result := claimedKey(dbFromContext(ctx, r.db), key).
+		Delete(&domain.IdempotencyKey{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrIdempotencyKeyLost
+	}
+	return nil
+}
+
+// claimedKey scopes a write to the in-progress claim the key was created
+// with, so a request whose lock timed out can't touch a retry's record.
+func claimedKey(db *gorm.DB, key *domain.IdempotencyKey) *gorm.DB {
+	return db.Model(&domain.IdempotencyKey{}).
+		Where("client_id = ? AND key = ?", key.ClientID, key.Key).
+		Where("created_at = ? AND completed_at IS NULL", key.CreatedAt)
+}
+
+func (r *IdempotencyKeyRepository) DeleteReclaimable(ctx context.Context, clientID, key string, now time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Where("client_id = ? AND key = ?", clientID, key).
+		Where("expires_at <= ? OR (completed_at IS NULL AND (locked_until IS NULL OR locked_until <= ?))", now, now).
+		Delete(&domain.IdempotencyKey{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *IdempotencyKeyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Where("expires_at <= ?", now).
+		Delete(&domain.IdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
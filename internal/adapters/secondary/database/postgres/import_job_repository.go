@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+)
+
+type ImportJobRepository struct {
+	db *gorm.DB
+}
+
+func NewImportJobRepository(db *gorm.DB) *ImportJobRepository {
+	return &ImportJobRepository{db: db}
+}
+
+func (r *ImportJobRepository) Create(ctx context.Context, job *domain.ImportJob) error {
+	return dbFromContext(ctx, r.db).Create(job).Error
+}
+
+func (r *ImportJobRepository) GetByID(ctx context.Context, id string) (*domain.ImportJob, error) {
+	var job domain.ImportJob
+	err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&job).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrImportJobNotFound
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *ImportJobRepository) Update(ctx context.Context, job *domain.ImportJob) error {
+	return dbFromContext(ctx, r.db).Save(job).Error
+}
+
+func (r *ImportJobRepository) AbortUnfinished(ctx context.Context, reason string) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&domain.ImportJob{}).
+		Where("status IN ?", []domain.ImportJobStatus{domain.ImportJobPending, domain.ImportJobRunning}).
+		Updates(map[string]interface{}{
+			"status":      domain.ImportJobFailed,
+			"error":       reason,
+			"finished_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
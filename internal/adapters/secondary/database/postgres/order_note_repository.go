@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+)
+
+type OrderNoteRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderNoteRepository(db *gorm.DB) *OrderNoteRepository {
+	return &OrderNoteRepository{db: db}
+}
+
+func (r *OrderNoteRepository) Create(ctx context.Context, note *domain.OrderNote) error {
+	return dbFromContext(ctx, r.db).Create(note).Error
+}
+
+func (r *OrderNoteRepository) GetByOrderID(ctx context.Context, orderID string) ([]*domain.OrderNote, error) {
+	var notes []*domain.OrderNote
+	err := dbFromContext(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&notes).Error
+	return notes, err
+}
@@ -3,10 +3,13 @@ package postgres
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type OrderRepository struct {
@@ -17,13 +20,40 @@ func NewOrderRepository(db *gorm.DB) *OrderRepository {
 	return &OrderRepository{db: db}
 }
 
+func orderParcels(db *gorm.DB) *gorm.DB {
+	return db.Order("sequence ASC")
+}
+
 func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) error {
-	return r.db.WithContext(ctx).Create(order).Error
+	return dbFromContext(ctx, r.db).Create(order).Error
 }
 
 func (r *OrderRepository) GetByID(ctx context.Context, id string) (*domain.Order, error) {
 	var order domain.Order
-	err := r.db.WithContext(ctx).Preload("Client").Where("id = ?", id).First(&order).Error
+	err := dbFromContext(ctx, r.db).
+		Scopes(notDeleted).
+		Preload("Client").
+		Preload("Parcels", orderParcels).
+		Preload("ProofOfDelivery").
+		Where("id = ?", id).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("order not found")
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *OrderRepository) GetByTrackingCode(ctx context.Context, code string) (*domain.Order, error) {
+	var order domain.Order
+	err := dbFromContext(ctx, r.db).
+		Scopes(notDeleted).
+		Preload("Parcels", orderParcels).
+		Preload("ProofOfDelivery").
+		Where("tracking_code = ?", code).
+		First(&order).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("order not found")
@@ -35,8 +65,10 @@ func (r *OrderRepository) GetByID(ctx context.Context, id string) (*domain.Order
 
 func (r *OrderRepository) GetByClientID(ctx context.Context, clientID string, limit, offset int) ([]*domain.Order, error) {
 	var orders []*domain.Order
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
+		Scopes(notDeleted).
 		Preload("Client").
+		Preload("Parcels", orderParcels).
 		Where("client_id = ?", clientID).
 		Order("created_at DESC").
 		Limit(limit).
@@ -47,8 +79,10 @@ func (r *OrderRepository) GetByClientID(ctx context.Context, clientID string, li
 
 func (r *OrderRepository) GetAll(ctx context.Context, limit, offset int) ([]*domain.Order, error) {
 	var orders []*domain.Order
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
+		Scopes(notDeleted).
 		Preload("Client").
+		Preload("Parcels", orderParcels).
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -56,18 +90,78 @@ func (r *OrderRepository) GetAll(ctx context.Context, limit, offset int) ([]*dom
 	return orders, err
 }
 
+// Update saves the order only when its version still matches the stored one,
+// so concurrent writers can't overwrite each other's changes.
 func (r *OrderRepository) Update(ctx context.Context, order *domain.Order) error {
-	return r.db.WithContext(ctx).Save(order).Error
+	db := dbFromContext(ctx, r.db)
+	expectedVersion := order.Version
+	order.Version++
+
+	result := db.Model(order).
+		Select("*").
+		Omit(clause.Associations).
+		Where("version = ?", expectedVersion).
+		Updates(order)
+	if result.Error != nil {
+		order.Version = expectedVersion
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		order.Version = expectedVersion
+		return domain.ErrVersionConflict
+	}
+
+	for i := range order.Parcels {
+		if err := db.Save(&order.Parcels[i]).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// GetDeletedByID loads an order that was soft-deleted, for restoring it.
+func (r *OrderRepository) GetDeletedByID(ctx context.Context, id string) (*domain.Order, error) {
+	var order domain.Order
+	err := dbFromContext(ctx, r.db).
+		Preload("Parcels", orderParcels).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("order not found")
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// Delete permanently removes the order together with its parcels, history,
+// delivery attempts, proof of delivery, notes and claims. Soft deletion goes
+// through Update.
 func (r *OrderRepository) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&domain.Order{}, "id = ?", id).Error
+	db := dbFromContext(ctx, r.db)
+	dependents := []interface{}{
+		&domain.Parcel{},
+		&domain.OrderStatusEvent{},
+		&domain.DeliveryAttempt{},
+		&domain.ProofOfDelivery{},
+		&domain.OrderNote{},
+		&domain.Claim{},
+	}
+	for _, model := range dependents {
+		if err := db.Where("order_id = ?", id).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	return db.Delete(&domain.Order{}, "id = ?", id).Error
 }
 
 func (r *OrderRepository) GetByStatus(ctx context.Context, status domain.OrderStatus, limit, offset int) ([]*domain.Order, error) {
 	var orders []*domain.Order
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
+		Scopes(notDeleted).
 		Preload("Client").
+		Preload("Parcels", orderParcels).
 		Where("status = ?", status).
 		Order("created_at DESC").
 		Limit(limit).
@@ -77,7 +171,7 @@ func (r *OrderRepository) GetByStatus(ctx context.Context, status domain.OrderSt
 }
 
 func (r *OrderRepository) UpdateStatus(ctx context.Context, orderID string, status domain.OrderStatus) error {
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&domain.Order{}).
 		Where("id = ?", orderID).
 		Update("status", status).Error
@@ -85,7 +179,8 @@ func (r *OrderRepository) UpdateStatus(ctx context.Context, orderID string, stat
 
 func (r *OrderRepository) CountByClientID(ctx context.Context, clientID string) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
+		Scopes(notDeleted).
 		Model(&domain.Order{}).
 		Where("client_id = ?", clientID).
 		Count(&count).Error
@@ -94,15 +189,155 @@ func (r *OrderRepository) CountByClientID(ctx context.Context, clientID string)
 
 func (r *OrderRepository) CountTotal(ctx context.Context) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&domain.Order{}).Count(&count).Error
+	err := dbFromContext(ctx, r.db).Scopes(notDeleted).Model(&domain.Order{}).Count(&count).Error
 	return count, err
 }
 
 func (r *OrderRepository) CountByStatus(ctx context.Context, status domain.OrderStatus) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
+		Scopes(notDeleted).
 		Model(&domain.Order{}).
 		Where("status = ?", status).
 		Count(&count).Error
 	return count, err
 }
+
+func (r *OrderRepository) List(ctx context.Context, filter repositories.OrderFilter, sort repositories.OrderSort, limit, offset int) ([]*domain.Order, error) {
+	var orders []*domain.Order
+	err := applyOrderFilter(dbFromContext(ctx, r.db), filter).
+		Preload("Client").
+		Preload("Parcels", orderParcels).
+		Order(orderByClause(sort)).
+		Limit(limit).
+		Offset(offset).
+		Find(&orders).Error
+	return orders, err
+}
+
+func (r *OrderRepository) ListAfter(ctx context.Context, filter repositories.OrderFilter, after *repositories.OrderCursor, ascending bool, limit int) ([]*domain.Order, error) {
+	db := applyOrderFilter(dbFromContext(ctx, r.db), filter)
+	if after != nil {
+		if ascending {
+			db = db.Where("(created_at, id) > (?, ?)", after.CreatedAt, after.ID)
+		} else {
+			db = db.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+		}
+	}
+
+	var orders []*domain.Order
+	err := db.
+		Preload("Client").
+		Preload("Parcels", orderParcels).
+		Order(orderByClause(repositories.OrderSort{Field: repositories.OrderSortCreatedAt, Ascending: ascending})).
+		Limit(limit).
+		Find(&orders).Error
+	return orders, err
+}
+
+func (r *OrderRepository) Count(ctx context.Context, filter repositories.OrderFilter) (int64, error) {
+	var count int64
+	err := applyOrderFilter(dbFromContext(ctx, r.db).Model(&domain.Order{}), filter).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *OrderRepository) ListCashCollections(ctx context.Context, filter repositories.CashCollectionFilter) ([]*domain.Order, error) {
+	db := dbFromContext(ctx, r.db).
+		Select("id", "tracking_code", "cod_amount", "cod_currency", "cod_collected_amount", "cod_collected_at", "cod_driver_id").
+		Where("cod_collected_at >= ? AND cod_collected_at < ?", filter.CollectedFrom, filter.CollectedTo)
+	if filter.DriverID != "" {
+		db = db.Where("cod_driver_id = ?", filter.DriverID)
+	}
+
+	var orders []*domain.Order
+	err := db.Order("cod_driver_id ASC, cod_collected_at ASC").Find(&orders).Error
+	return orders, err
+}
+
+func notDeleted(db *gorm.DB) *gorm.DB {
+	return db.Where("deleted_at IS NULL")
+}
+
+func applyOrderFilter(db *gorm.DB, filter repositories.OrderFilter) *gorm.DB {
+	switch {
+	case filter.OnlyDeleted:
+		db = db.Where("deleted_at IS NOT NULL")
+	case !filter.IncludeDeleted:
+		db = db.Scopes(notDeleted)
+	}
+	if filter.ClientID != "" {
+		db = db.Where("client_id = ?", filter.ClientID)
+	}
+	if len(filter.Statuses) > 0 {
+		db = db.Where("status IN ?", filter.Statuses)
+	}
+	if filter.PackageSize != "" {
+		db = db.Where("package_size = ?", filter.PackageSize)
+	}
+	if filter.CreatedFrom != nil {
+		db = db.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		db = db.Where("created_at <= ?", *filter.CreatedTo)
+	}
+	if filter.PickupFrom != nil {
+		db = db.Where("pickup_end >= ?", *filter.PickupFrom)
+	}
+	if filter.PickupTo != nil {
+		db = db.Where("pickup_start <= ?", *filter.PickupTo)
+	}
+	if filter.DeliveryFrom != nil {
+		db = db.Where("delivery_end >= ?", *filter.DeliveryFrom)
+	}
+	if filter.DeliveryTo != nil {
+		db = db.Where("delivery_start <= ?", *filter.DeliveryTo)
+	}
+	if filter.OriginCity != "" {
+		db = db.Where("LOWER(origin_addr_city) = LOWER(?)", filter.OriginCity)
+	}
+	if filter.DestinationCity != "" {
+		db = db.Where("LOWER(dest_addr_city) = LOWER(?)", filter.DestinationCity)
+	}
+	if filter.OriginZipPrefix != "" {
+		db = db.Where("origin_addr_zip_code LIKE ?", escapeLike(filter.OriginZipPrefix)+"%")
+	}
+	if filter.DestinationZipPrefix != "" {
+		db = db.Where("dest_addr_zip_code LIKE ?", escapeLike(filter.DestinationZipPrefix)+"%")
+	}
+	if filter.ClosedBefore != nil {
+		db = db.Where("closed_at < ?", *filter.ClosedBefore)
+	}
+	if filter.PromisedBefore != nil {
+		db = db.Where("promised_delivery_at < ?", *filter.PromisedBefore)
+	}
+	if len(filter.ExcludeStatuses) > 0 {
+		db = db.Where("status NOT IN ?", filter.ExcludeStatuses)
+	}
+	return db
+}
+
+// orderSortColumns whitelists the sortable columns.
+var orderSortColumns = map[repositories.OrderSortField]string{
+	repositories.OrderSortCreatedAt:   "created_at",
+	repositories.OrderSortUpdatedAt:   "updated_at",
+	repositories.OrderSortTotalWeight: "total_weight",
+}
+
+// orderByClause sorts by the requested column, breaking ties by id so pages
+// stay stable.
+func orderByClause(sort repositories.OrderSort) clause.OrderBy {
+	column, ok := orderSortColumns[sort.Field]
+	if !ok {
+		column = orderSortColumns[repositories.OrderSortCreatedAt]
+	}
+
+	return clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: clause.Column{Name: column}, Desc: !sort.Ascending},
+		{Column: clause.Column{Name: "id"}, Desc: !sort.Ascending},
+	}}
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+)
+
+type OrderStatusEventRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderStatusEventRepository(db *gorm.DB) *OrderStatusEventRepository {
+	return &OrderStatusEventRepository{db: db}
+}
+
+func (r *OrderStatusEventRepository) Create(ctx context.Context, event *domain.OrderStatusEvent) error {
+	return dbFromContext(ctx, r.db).Create(event).Error
+}
+
+func (r *OrderStatusEventRepository) GetByOrderID(ctx context.Context, orderID string) ([]*domain.OrderStatusEvent, error) {
+	var events []*domain.OrderStatusEvent
+	err := dbFromContext(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&events).Error
+	return events, err
+}
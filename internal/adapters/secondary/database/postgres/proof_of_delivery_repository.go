@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+)
+
+type ProofOfDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewProofOfDeliveryRepository(db *gorm.DB) *ProofOfDeliveryRepository {
+	return &ProofOfDeliveryRepository{db: db}
+}
+
+func (r *ProofOfDeliveryRepository) Create(ctx context.Context, proof *domain.ProofOfDelivery) error {
+	return dbFromContext(ctx, r.db).Create(proof).Error
+}
+
+func (r *ProofOfDeliveryRepository) GetByOrderID(ctx context.Context, orderID string) (*domain.ProofOfDelivery, error) {
+	var proofs []*domain.ProofOfDelivery
+	err := dbFromContext(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Limit(1).
+		Find(&proofs).Error
+	if err != nil || len(proofs) == 0 {
+		return nil, err
+	}
+	return proofs[0], nil
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+)
+
+type ShipmentQuoteRepository struct {
+	db *gorm.DB
+}
+
+func NewShipmentQuoteRepository(db *gorm.DB) *ShipmentQuoteRepository {
+	return &ShipmentQuoteRepository{db: db}
+}
+
+func (r *ShipmentQuoteRepository) Create(ctx context.Context, quote *domain.ShipmentQuote) error {
+	return dbFromContext(ctx, r.db).Create(quote).Error
+}
+
+func (r *ShipmentQuoteRepository) GetByID(ctx context.Context, id string) (*domain.ShipmentQuote, error) {
+	var quote domain.ShipmentQuote
+	err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&quote).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("quote not found")
+		}
+		return nil, err
+	}
+	return &quote, nil
+}
+
+func (r *ShipmentQuoteRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Where("expires_at <= ?", now).
+		Delete(&domain.ShipmentQuote{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"logistics-api/internal/core/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// workflowDefinition stores a serialized domain.Workflow per service type.
+type workflowDefinition struct {
+	ServiceType domain.ServiceType `gorm:"primaryKey"`
+	Name        string             `gorm:"not null"`
+	Definition  string             `gorm:"type:jsonb;not null"`
+	UpdatedAt   time.Time          `gorm:"autoUpdateTime"`
+}
+
+func (workflowDefinition) TableName() string {
+	return "workflow_definitions"
+}
+
+type WorkflowRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkflowRepository(db *gorm.DB) *WorkflowRepository {
+	return &WorkflowRepository{db: db}
+}
+
+func (r *WorkflowRepository) GetAll(ctx context.Context) ([]*domain.Workflow, error) {
+	var definitions []workflowDefinition
+	if err := dbFromContext(ctx, r.db).Order("service_type ASC").Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+
+	workflows := make([]*domain.Workflow, 0, len(definitions))
+	for _, definition := range definitions {
+		var workflow domain.Workflow
+		if err := json.Unmarshal([]byte(definition.Definition), &workflow); err != nil {
+			return nil, fmt.Errorf("invalid workflow definition for %s: %w", definition.ServiceType, err)
+		}
+		workflows = append(workflows, &workflow)
+	}
+	return workflows, nil
+}
+
+func (r *WorkflowRepository) Save(ctx context.Context, workflow *domain.Workflow) error {
+	definition, err := json.Marshal(workflow)
+	if err != nil {
+		return err
+	}
+
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(&workflowDefinition{
+			ServiceType: workflow.ServiceType,
+			Name:        workflow.Name,
+			Definition:  string(definition),
+		}).Error
+}
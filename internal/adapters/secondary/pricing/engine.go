@@ -0,0 +1,80 @@
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/pkg/logger"
+)
+
+// Engine prices shipments with the rate table of their service type. Tables in
+// the rates file replace the built-in defaults for the same service type.
+type Engine struct {
+	coordService services.CoordinateService
+	tables       map[domain.ServiceType]*domain.RateTable
+	logger       logger.Logger
+}
+
+func NewEngine(coordService services.CoordinateService, ratesPath string, log logger.Logger) (*Engine, error) {
+	tables := make(map[domain.ServiceType]*domain.RateTable)
+	for _, table := range domain.DefaultRateTables() {
+		tables[table.ServiceType] = table
+	}
+
+	if ratesPath != "" {
+		fileTables, err := loadFile(ratesPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, table := range fileTables {
+			tables[table.ServiceType] = table
+		}
+		log.Info("Rate tables loaded from file",
+			logger.String("path", ratesPath),
+			logger.Int("count", len(fileTables)))
+	}
+
+	return &Engine{
+		coordService: coordService,
+		tables:       tables,
+		logger:       log,
+	}, nil
+}
+
+func (e *Engine) Quote(ctx context.Context, origin, destination domain.Coordinates, size domain.PackageSize, serviceType domain.ServiceType) (*domain.PriceQuote, error) {
+	table, ok := e.tables[serviceType]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", domain.ErrNoRateTable, serviceType)
+	}
+
+	distance, err := e.coordService.GetDistanceBetweenPoints(ctx, origin, destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate distance: %w", err)
+	}
+
+	return table.Quote(distance, size)
+}
+
+func loadFile(path string) ([]*domain.RateTable, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate tables: %w", err)
+	}
+
+	var tables []*domain.RateTable
+	if err := json.Unmarshal(content, &tables); err != nil {
+		return nil, fmt.Errorf("failed to parse rate tables: %w", err)
+	}
+
+	for _, table := range tables {
+		if err := table.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rate table for %q: %w", table.ServiceType, err)
+		}
+	}
+
+	return tables, nil
+}
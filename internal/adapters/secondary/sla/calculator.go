@@ -0,0 +1,82 @@
+package sla
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/pkg/logger"
+)
+
+// Calculator promises delivery dates from the haversine distance, the SLA
+// policy of the order's service type and the business calendar. Policies in
+// the policies file replace the built-in defaults for the same service type.
+type Calculator struct {
+	coordService services.CoordinateService
+	calendar     *domain.BusinessCalendar
+	policies     map[domain.ServiceType]*domain.SLAPolicy
+}
+
+func NewCalculator(coordService services.CoordinateService, calendar *domain.BusinessCalendar, policiesPath string, log logger.Logger) (*Calculator, error) {
+	policies := make(map[domain.ServiceType]*domain.SLAPolicy)
+	for _, policy := range domain.DefaultSLAPolicies() {
+		policies[policy.ServiceType] = policy
+	}
+
+	if policiesPath != "" {
+		filePolicies, err := loadFile(policiesPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range filePolicies {
+			policies[policy.ServiceType] = policy
+		}
+		log.Info("SLA policies loaded from file",
+			logger.String("path", policiesPath),
+			logger.Int("count", len(filePolicies)))
+	}
+
+	return &Calculator{
+		coordService: coordService,
+		calendar:     calendar,
+		policies:     policies,
+	}, nil
+}
+
+func (c *Calculator) PromisedDeliveryAt(ctx context.Context, order *domain.Order) (time.Time, error) {
+	policy, ok := c.policies[order.ServiceType]
+	if !ok {
+		return time.Time{}, fmt.Errorf("no SLA policy defined for service type %s", order.ServiceType)
+	}
+
+	distance, err := c.coordService.GetDistanceBetweenPoints(ctx, order.OriginCoords, order.DestinationCoords)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to calculate distance: %w", err)
+	}
+
+	return c.calendar.AddBusinessDays(order.SLAStartAt(), policy.BusinessDaysFor(distance)), nil
+}
+
+func loadFile(path string) ([]*domain.SLAPolicy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SLA policies: %w", err)
+	}
+
+	var policies []*domain.SLAPolicy
+	if err := json.Unmarshal(content, &policies); err != nil {
+		return nil, fmt.Errorf("failed to parse SLA policies: %w", err)
+	}
+
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid SLA policy for %q: %w", policy.ServiceType, err)
+		}
+	}
+
+	return policies, nil
+}
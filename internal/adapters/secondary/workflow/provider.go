@@ -0,0 +1,160 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/pkg/logger"
+)
+
+// Provider resolves the workflow for a service type. Definitions stored in the
+// database win over the ones in the config file, which win over the built-in
+// defaults.
+//
+// The resolved set is cached; SaveWorkflow drops it right away and cacheTTL
+// bounds how long other instances keep serving a stale copy.
+type Provider struct {
+	repo          repositories.WorkflowRepository
+	fileWorkflows map[domain.ServiceType]*domain.Workflow
+	logger        logger.Logger
+
+	mu       sync.RWMutex
+	cached   []*domain.Workflow
+	cachedAt time.Time
+	cacheTTL time.Duration
+}
+
+func NewProvider(repo repositories.WorkflowRepository, configPath string, log logger.Logger) (*Provider, error) {
+	fileWorkflows := make(map[domain.ServiceType]*domain.Workflow)
+
+	if configPath != "" {
+		workflows, err := loadFile(configPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, wf := range workflows {
+			fileWorkflows[wf.ServiceType] = wf
+		}
+		log.Info("Workflow definitions loaded from file",
+			logger.String("path", configPath),
+			logger.Int("count", len(workflows)))
+	}
+
+	return &Provider{
+		repo:          repo,
+		fileWorkflows: fileWorkflows,
+		logger:        log,
+		cacheTTL:      time.Minute,
+	}, nil
+}
+
+func (p *Provider) GetWorkflow(ctx context.Context, serviceType domain.ServiceType) (*domain.Workflow, error) {
+	workflows, err := p.GetWorkflows(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wf := range workflows {
+		if wf.ServiceType == serviceType {
+			return wf, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no workflow defined for service type %s", serviceType)
+}
+
+func (p *Provider) GetWorkflows(ctx context.Context) ([]*domain.Workflow, error) {
+	p.mu.RLock()
+	cached, cachedAt := p.cached, p.cachedAt
+	p.mu.RUnlock()
+
+	if cached != nil && time.Since(cachedAt) < p.cacheTTL {
+		return cached, nil
+	}
+
+	workflows, err := p.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.cached, p.cachedAt = workflows, time.Now()
+	p.mu.Unlock()
+
+	return workflows, nil
+}
+
+// SaveWorkflow stores a definition and drops the cached set so the next
+// lookup picks it up.
+func (p *Provider) SaveWorkflow(ctx context.Context, workflow *domain.Workflow) error {
+	if err := p.repo.Save(ctx, workflow); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.cached = nil
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *Provider) resolve(ctx context.Context) ([]*domain.Workflow, error) {
+	resolved := make(map[domain.ServiceType]*domain.Workflow)
+
+	for _, wf := range domain.DefaultWorkflows() {
+		resolved[wf.ServiceType] = wf
+	}
+
+	for serviceType, wf := range p.fileWorkflows {
+		resolved[serviceType] = wf
+	}
+
+	stored, err := p.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wf := range stored {
+		if err := wf.Validate(); err != nil {
+			p.logger.Warn("Ignoring invalid stored workflow",
+				logger.String("service_type", string(wf.ServiceType)),
+				logger.Error(err))
+			continue
+		}
+		resolved[wf.ServiceType] = wf
+	}
+
+	workflows := make([]*domain.Workflow, 0, len(resolved))
+	for _, serviceType := range domain.GetValidServiceTypes() {
+		if wf, ok := resolved[serviceType]; ok {
+			workflows = append(workflows, wf)
+		}
+	}
+	return workflows, nil
+}
+
+func loadFile(path string) ([]*domain.Workflow, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workflow config: %w", err)
+	}
+
+	var workflows []*domain.Workflow
+	if err := json.Unmarshal(content, &workflows); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow config: %w", err)
+	}
+
+	for _, wf := range workflows {
+		if err := wf.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid workflow %q in config: %w", wf.Name, err)
+		}
+	}
+
+	return workflows, nil
+}
@@ -1,6 +1,9 @@
 package app
 
 import (
+	"context"
+	"time"
+
 	"logistics-api/internal/adapters/primary/health"
 	"logistics-api/internal/adapters/primary/http"
 	"logistics-api/internal/adapters/primary/http/handlers"
@@ -9,9 +12,18 @@ import (
 	"logistics-api/internal/adapters/secondary/database/postgres"
 	"logistics-api/internal/adapters/secondary/external"
 	loggerAdapter "logistics-api/internal/adapters/secondary/logger"
+	pricingAdapter "logistics-api/internal/adapters/secondary/pricing"
+	slaAdapter "logistics-api/internal/adapters/secondary/sla"
+	storageAdapter "logistics-api/internal/adapters/secondary/storage"
+	workflowAdapter "logistics-api/internal/adapters/secondary/workflow"
 	"logistics-api/internal/config"
+	"logistics-api/internal/core/domain"
+	archiveUseCase "logistics-api/internal/core/usecases/archive"
 	authUseCase "logistics-api/internal/core/usecases/auth"
 	"logistics-api/internal/core/usecases/order"
+	pricingUseCase "logistics-api/internal/core/usecases/pricing"
+	trackingUseCase "logistics-api/internal/core/usecases/tracking"
+	workflowUseCase "logistics-api/internal/core/usecases/workflow"
 	"logistics-api/internal/pkg/logger"
 	"logistics-api/internal/pkg/validator"
 
@@ -29,26 +41,83 @@ type Container struct {
 	// Services
 	AuthService       *authService.JWTService
 	CoordinateService *external.CoordinateService
+	WorkflowProvider  *workflowAdapter.Provider
+	PricingEngine     *pricingAdapter.Engine
+	SLACalculator     *slaAdapter.Calculator
+	InsurancePolicy   domain.InsurancePolicy
+	FileStorage       *storageAdapter.LocalStorage
+	Validator         *validator.Validator
 
 	// Repositories
-	UserRepository  *postgres.UserRepository
-	OrderRepository *postgres.OrderRepository
+	TransactionManager         *postgres.TransactionManager
+	UserRepository             *postgres.UserRepository
+	OrderRepository            *postgres.OrderRepository
+	OrderStatusEventRepository *postgres.OrderStatusEventRepository
+	DeliveryAttemptRepository  *postgres.DeliveryAttemptRepository
+	ProofOfDeliveryRepository  *postgres.ProofOfDeliveryRepository
+	WorkflowRepository         *postgres.WorkflowRepository
+	ImportJobRepository        *postgres.ImportJobRepository
+	IdempotencyKeyRepository   *postgres.IdempotencyKeyRepository
+	ShipmentQuoteRepository    *postgres.ShipmentQuoteRepository
+	ArchivedOrderRepository    *postgres.ArchivedOrderRepository
+	OrderNoteRepository        *postgres.OrderNoteRepository
+	ClaimRepository            *postgres.ClaimRepository
 
 	// Use Cases
-	RegisterUC     *authUseCase.RegisterUseCase
-	LoginUC        *authUseCase.LoginUseCase
-	CreateOrderUC  *order.CreateOrderUseCase
-	GetOrdersUC    *order.GetOrdersUseCase
-	UpdateStatusUC *order.UpdateOrderStatusUseCase
+	RegisterUC      *authUseCase.RegisterUseCase
+	LoginUC         *authUseCase.LoginUseCase
+	CreateOrderUC   *order.CreateOrderUseCase
+	GetOrdersUC     *order.GetOrdersUseCase
+	UpdateStatusUC  *order.UpdateOrderStatusUseCase
+	GetOrderUC      *order.GetOrderByIDUseCase
+	GetHistoryUC    *order.GetOrderHistoryUseCase
+	CancelOrderUC   *order.CancelOrderUseCase
+	UpdateOrderUC   *order.UpdateOrderUseCase
+	BatchStatusUC   *order.BatchUpdateStatusUseCase
+	ExportOrdersUC  *order.ExportOrdersUseCase
+	DeleteOrderUC   *order.DeleteOrderUseCase
+	RestoreOrderUC  *order.RestoreOrderUseCase
+	RecordAttemptUC *order.RecordDeliveryAttemptUseCase
+	GetAttemptsUC   *order.GetDeliveryAttemptsUseCase
+	ParcelStatusUC  *order.UpdateParcelStatusUseCase
+	DeliverOrderUC  *order.DeliverOrderUseCase
+	GetProofFileUC  *order.GetProofFileUseCase
+	ReconcileCashUC *order.ReconcileCashUseCase
+	AddNoteUC       *order.AddOrderNoteUseCase
+	GetNotesUC      *order.GetOrderNotesUseCase
+	FileClaimUC     *order.FileClaimUseCase
+	GetClaimsUC     *order.GetOrderClaimsUseCase
+	ListClaimsUC    *order.ListClaimsUseCase
+	ResolveClaimUC  *order.ResolveClaimUseCase
+	ImportOrdersUC  *order.ImportOrdersUseCase
+	GetImportJobUC  *order.GetImportJobUseCase
+	AttachQuoteUC   *order.AttachQuoteUseCase
+	RespondQuoteUC  *order.RespondToQuoteUseCase
+	ListWorkflowsUC *workflowUseCase.ListWorkflowsUseCase
+	SaveWorkflowUC  *workflowUseCase.SaveWorkflowUseCase
+	GetQuoteUC      *pricingUseCase.GetQuoteUseCase
+	TrackOrderUC    *trackingUseCase.TrackOrderUseCase
+	ArchiveOrdersUC *archiveUseCase.ArchiveOrdersUseCase
+	SearchArchiveUC *archiveUseCase.SearchArchivedOrdersUseCase
+	GetArchivedUC   *archiveUseCase.GetArchivedOrderUseCase
 
 	// HTTP Layer
-	Validator      *validator.Validator
-	AuthMiddleware *middleware.AuthMiddleware
-	AuthHandler    *handlers.AuthHandler
-	OrderHandler   *handlers.OrderHandler
-	HealthHandler  *health.HealthHandler
-	Router         *http.Router
-	Server         *http.Server
+	AuthMiddleware  *middleware.AuthMiddleware
+	Idempotency     *middleware.Idempotency
+	AuthHandler     *handlers.AuthHandler
+	OrderHandler    *handlers.OrderHandler
+	DeliveryHandler *handlers.DeliveryHandler
+	NoteHandler     *handlers.NoteHandler
+	ClaimHandler    *handlers.ClaimHandler
+	ImportHandler   *handlers.ImportHandler
+	QuoteHandler    *handlers.QuoteHandler
+	PricingHandler  *handlers.PricingHandler
+	WorkflowHandler *handlers.WorkflowHandler
+	TrackingHandler *handlers.TrackingHandler
+	ArchiveHandler  *handlers.ArchiveHandler
+	HealthHandler   *health.HealthHandler
+	Router          *http.Router
+	Server          *http.Server
 }
 
 func NewContainer() (*Container, error) {
@@ -112,17 +181,94 @@ func (c *Container) initServices() error {
 	// Coordinate service
 	c.CoordinateService = external.NewCoordinateService()
 
+	// Pricing engine
+	pricingEngine, err := pricingAdapter.NewEngine(c.CoordinateService, c.Config.Order.PricingRatesPath, c.Logger)
+	if err != nil {
+		return err
+	}
+	c.PricingEngine = pricingEngine
+
+	// SLA calculator
+	calendar, err := domain.NewBusinessCalendar(
+		c.Config.Order.SLATimeZone,
+		c.Config.Order.SLAHolidays,
+		c.Config.Order.SLACutoffHour,
+		c.Config.Order.SLADeliveryHour,
+	)
+	if err != nil {
+		return err
+	}
+	slaCalculator, err := slaAdapter.NewCalculator(c.CoordinateService, calendar, c.Config.Order.SLAPoliciesPath, c.Logger)
+	if err != nil {
+		return err
+	}
+	c.SLACalculator = slaCalculator
+
+	// Shipment insurance
+	c.InsurancePolicy = domain.InsurancePolicy{
+		Rate:        c.Config.Order.InsuranceRate,
+		MaxCoverage: c.Config.Order.InsuranceMaxCoverage,
+	}
+
+	// File storage
+	fileStorage, err := storageAdapter.NewLocalStorage(c.Config.Storage.LocalPath)
+	if err != nil {
+		return err
+	}
+	c.FileStorage = fileStorage
+
+	// Validator, shared by the handlers and bulk order imports
+	c.Validator = validator.New()
+
 	c.Logger.Info("Services initialized successfully")
 	return nil
 }
 
 func (c *Container) initRepositories() error {
+	// Transaction manager
+	c.TransactionManager = postgres.NewTransactionManager(c.DB)
+
 	// User repository
 	c.UserRepository = postgres.NewUserRepository(c.DB)
 
 	// Order repository
 	c.OrderRepository = postgres.NewOrderRepository(c.DB)
 
+	// Order status event repository
+	c.OrderStatusEventRepository = postgres.NewOrderStatusEventRepository(c.DB)
+
+	// Delivery attempt repository
+	c.DeliveryAttemptRepository = postgres.NewDeliveryAttemptRepository(c.DB)
+
+	// Proof of delivery repository
+	c.ProofOfDeliveryRepository = postgres.NewProofOfDeliveryRepository(c.DB)
+
+	// Import job repository
+	c.ImportJobRepository = postgres.NewImportJobRepository(c.DB)
+
+	// Idempotency key repository
+	c.IdempotencyKeyRepository = postgres.NewIdempotencyKeyRepository(c.DB)
+
+	// Shipment quote repository
+	c.ShipmentQuoteRepository = postgres.NewShipmentQuoteRepository(c.DB)
+
+	// Archived order repository
+	c.ArchivedOrderRepository = postgres.NewArchivedOrderRepository(c.DB)
+
+	// Order note repository
+	c.OrderNoteRepository = postgres.NewOrderNoteRepository(c.DB)
+
+	// Claim repository
+	c.ClaimRepository = postgres.NewClaimRepository(c.DB)
+
+	// Workflow repository and provider
+	c.WorkflowRepository = postgres.NewWorkflowRepository(c.DB)
+	workflowProvider, err := workflowAdapter.NewProvider(c.WorkflowRepository, c.Config.Order.WorkflowConfigPath, c.Logger)
+	if err != nil {
+		return err
+	}
+	c.WorkflowProvider = workflowProvider
+
 	c.Logger.Info("Repositories initialized successfully")
 	return nil
 }
@@ -133,35 +279,162 @@ func (c *Container) initUseCases() error {
 	c.LoginUC = authUseCase.NewLoginUseCase(c.UserRepository, c.AuthService, c.Logger)
 
 	// Order use cases
-	c.CreateOrderUC = order.NewCreateOrderUseCase(c.OrderRepository, c.UserRepository, c.CoordinateService, c.Logger)
+	c.CreateOrderUC = order.NewCreateOrderUseCase(c.OrderRepository, c.UserRepository, c.OrderStatusEventRepository, c.ShipmentQuoteRepository, c.TransactionManager, c.CoordinateService, c.PricingEngine, c.SLACalculator, c.Config.Order.VolumetricDivisor, c.InsurancePolicy, c.Logger)
 	c.GetOrdersUC = order.NewGetOrdersUseCase(c.OrderRepository, c.UserRepository, c.Logger)
-	c.UpdateStatusUC = order.NewUpdateOrderStatusUseCase(c.OrderRepository, c.Logger)
+	c.UpdateStatusUC = order.NewUpdateOrderStatusUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.TransactionManager, c.WorkflowProvider, c.Logger)
+	c.BatchStatusUC = order.NewBatchUpdateStatusUseCase(c.OrderRepository, c.UpdateStatusUC, c.Logger)
+	c.ExportOrdersUC = order.NewExportOrdersUseCase(c.OrderRepository, c.Logger)
+	c.DeleteOrderUC = order.NewDeleteOrderUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.TransactionManager, c.Logger)
+	c.RestoreOrderUC = order.NewRestoreOrderUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.TransactionManager, c.Logger)
+	c.GetOrderUC = order.NewGetOrderByIDUseCase(c.OrderRepository, c.CoordinateService, c.Logger)
+	c.GetHistoryUC = order.NewGetOrderHistoryUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.Logger)
+	c.CancelOrderUC = order.NewCancelOrderUseCase(
+		c.OrderRepository,
+		c.OrderStatusEventRepository,
+		c.TransactionManager,
+		c.WorkflowProvider,
+		time.Duration(c.Config.Order.CancellationCutoffMinutes)*time.Minute,
+		c.Logger,
+	)
+
+	c.UpdateOrderUC = order.NewUpdateOrderUseCase(
+		c.OrderRepository,
+		c.OrderStatusEventRepository,
+		c.TransactionManager,
+		c.CoordinateService,
+		c.PricingEngine,
+		c.SLACalculator,
+		c.Config.Order.VolumetricDivisor,
+		c.Logger,
+	)
+
+	c.RecordAttemptUC = order.NewRecordDeliveryAttemptUseCase(
+		c.OrderRepository,
+		c.DeliveryAttemptRepository,
+		c.OrderStatusEventRepository,
+		c.TransactionManager,
+		c.WorkflowProvider,
+		c.Config.Order.MaxDeliveryAttempts,
+		c.Logger,
+	)
+	c.GetAttemptsUC = order.NewGetDeliveryAttemptsUseCase(c.OrderRepository, c.DeliveryAttemptRepository, c.Logger)
+
+	c.ParcelStatusUC = order.NewUpdateParcelStatusUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.TransactionManager, c.WorkflowProvider, c.Logger)
+	c.DeliverOrderUC = order.NewDeliverOrderUseCase(
+		c.OrderRepository,
+		c.ProofOfDeliveryRepository,
+		c.OrderStatusEventRepository,
+		c.TransactionManager,
+		c.WorkflowProvider,
+		c.FileStorage,
+		c.Logger,
+	)
+	c.GetProofFileUC = order.NewGetProofFileUseCase(c.OrderRepository, c.FileStorage, c.Logger)
+	c.ReconcileCashUC = order.NewReconcileCashUseCase(c.OrderRepository, c.Config.Order.SLATimeZone, c.Logger)
+	c.AddNoteUC = order.NewAddOrderNoteUseCase(c.OrderRepository, c.OrderNoteRepository, c.Logger)
+	c.GetNotesUC = order.NewGetOrderNotesUseCase(c.OrderRepository, c.OrderNoteRepository, c.Logger)
+	c.FileClaimUC = order.NewFileClaimUseCase(c.OrderRepository, c.ClaimRepository, c.Logger)
+	c.GetClaimsUC = order.NewGetOrderClaimsUseCase(c.OrderRepository, c.ClaimRepository, c.Logger)
+	c.ListClaimsUC = order.NewListClaimsUseCase(c.ClaimRepository, c.Logger)
+	c.ResolveClaimUC = order.NewResolveClaimUseCase(c.ClaimRepository, c.Logger)
+	c.ImportOrdersUC = order.NewImportOrdersUseCase(c.CreateOrderUC, c.ImportJobRepository, c.Validator, c.Config.Order.ImportSyncRows, c.Config.Order.ImportQueueSize, c.Logger)
+	if err := c.ImportOrdersUC.RecoverInterrupted(context.Background()); err != nil {
+		return err
+	}
+	c.ImportOrdersUC.StartWorkers(c.Config.Order.ImportWorkers)
+	c.GetImportJobUC = order.NewGetImportJobUseCase(c.ImportJobRepository, c.Logger)
+	c.AttachQuoteUC = order.NewAttachQuoteUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.TransactionManager, c.WorkflowProvider, c.Logger)
+	c.RespondQuoteUC = order.NewRespondToQuoteUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.TransactionManager, c.WorkflowProvider, c.SLACalculator, c.Logger)
+
+	// Workflow use cases
+	c.ListWorkflowsUC = workflowUseCase.NewListWorkflowsUseCase(c.WorkflowProvider, c.Logger)
+	c.SaveWorkflowUC = workflowUseCase.NewSaveWorkflowUseCase(c.WorkflowProvider, c.Logger)
+
+	// Pricing use cases
+	c.GetQuoteUC = pricingUseCase.NewGetQuoteUseCase(
+		c.ShipmentQuoteRepository,
+		c.CoordinateService,
+		c.PricingEngine,
+		c.InsurancePolicy,
+		time.Duration(c.Config.Order.QuoteTTLMinutes)*time.Minute,
+		c.Logger,
+	)
+	c.GetQuoteUC.StartCleanup(time.Hour)
+
+	// Tracking use cases
+	c.TrackOrderUC = trackingUseCase.NewTrackOrderUseCase(c.OrderRepository, c.OrderStatusEventRepository, c.Logger)
+
+	// Archive use cases; ORDER_ARCHIVE_AFTER_MONTHS=0 turns the archival job off
+	c.ArchiveOrdersUC = archiveUseCase.NewArchiveOrdersUseCase(
+		c.OrderRepository,
+		c.OrderStatusEventRepository,
+		c.DeliveryAttemptRepository,
+		c.ProofOfDeliveryRepository,
+		c.OrderNoteRepository,
+		c.ClaimRepository,
+		c.ArchivedOrderRepository,
+		c.TransactionManager,
+		c.Config.Order.ArchiveAfterMonths,
+		c.Logger,
+	)
+	if c.Config.Order.ArchiveAfterMonths > 0 {
+		c.ArchiveOrdersUC.StartSchedule(time.Duration(c.Config.Order.ArchiveIntervalHours) * time.Hour)
+	}
+	c.SearchArchiveUC = archiveUseCase.NewSearchArchivedOrdersUseCase(c.ArchivedOrderRepository, c.Logger)
+	c.GetArchivedUC = archiveUseCase.NewGetArchivedOrderUseCase(c.ArchivedOrderRepository, c.Logger)
 
 	c.Logger.Info("Use cases initialized successfully")
 	return nil
 }
 
 func (c *Container) initHTTPLayer() error {
-	// Validator
-	c.Validator = validator.New()
-
 	// Middleware
 	c.AuthMiddleware = middleware.NewAuthMiddleware(c.AuthService, c.Logger)
+	c.Idempotency = middleware.NewIdempotency(
+		c.IdempotencyKeyRepository,
+		time.Duration(c.Config.Order.IdempotencyKeyTTLHours)*time.Hour,
+		time.Duration(c.Config.Order.IdempotencyLockMinutes)*time.Minute,
+		c.Logger,
+	)
+	c.Idempotency.StartCleanup(time.Hour)
 
 	// Handlers
 	c.AuthHandler = handlers.NewAuthHandler(c.RegisterUC, c.LoginUC, c.Validator, c.Logger)
-	c.OrderHandler = handlers.NewOrderHandler(c.CreateOrderUC, c.GetOrdersUC, c.UpdateStatusUC, c.Validator, c.Logger)
+	c.OrderHandler = handlers.NewOrderHandler(c.CreateOrderUC, c.GetOrdersUC, c.UpdateStatusUC, c.GetOrderUC, c.GetHistoryUC, c.CancelOrderUC, c.UpdateOrderUC, c.BatchStatusUC, c.ExportOrdersUC, c.DeleteOrderUC, c.RestoreOrderUC, c.Validator, c.Logger)
+	c.DeliveryHandler = handlers.NewDeliveryHandler(c.RecordAttemptUC, c.GetAttemptsUC, c.ParcelStatusUC, c.DeliverOrderUC, c.GetProofFileUC, c.ReconcileCashUC, c.Validator, c.Logger)
+	c.NoteHandler = handlers.NewNoteHandler(c.AddNoteUC, c.GetNotesUC, c.Validator, c.Logger)
+	c.ClaimHandler = handlers.NewClaimHandler(c.FileClaimUC, c.GetClaimsUC, c.ListClaimsUC, c.ResolveClaimUC, c.Validator, c.Logger)
+	c.ImportHandler = handlers.NewImportHandler(c.ImportOrdersUC, c.GetImportJobUC, c.Config.Order.ImportMaxRows, c.Logger)
+	c.QuoteHandler = handlers.NewQuoteHandler(c.AttachQuoteUC, c.RespondQuoteUC, c.Validator, c.Logger)
+	c.PricingHandler = handlers.NewPricingHandler(c.GetQuoteUC, c.Validator, c.Logger)
+	c.WorkflowHandler = handlers.NewWorkflowHandler(c.ListWorkflowsUC, c.SaveWorkflowUC, c.Validator, c.Logger)
+	c.TrackingHandler = handlers.NewTrackingHandler(c.TrackOrderUC, c.Logger)
+	c.ArchiveHandler = handlers.NewArchiveHandler(c.SearchArchiveUC, c.GetArchivedUC, c.Validator, c.Logger)
 	c.HealthHandler = health.NewHealthHandler(c.DB, c.Logger)
 
 	// Router
 	routerConfig := http.RouterConfig{
-		AuthHandler:    c.AuthHandler,
-		OrderHandler:   c.OrderHandler,
-		HealthHandler:  c.HealthHandler,
-		AuthMiddleware: c.AuthMiddleware,
-		Logger:         c.Logger,
-		RateLimitRPS:   10.0, // 10 requests per second
-		RateLimitBurst: 20,   // Burst of 20 requests
+		AuthHandler:     c.AuthHandler,
+		OrderHandler:    c.OrderHandler,
+		DeliveryHandler: c.DeliveryHandler,
+		NoteHandler:     c.NoteHandler,
+		ClaimHandler:    c.ClaimHandler,
+		ImportHandler:   c.ImportHandler,
+		QuoteHandler:    c.QuoteHandler,
+		PricingHandler:  c.PricingHandler,
+		WorkflowHandler: c.WorkflowHandler,
+		TrackingHandler: c.TrackingHandler,
+		ArchiveHandler:  c.ArchiveHandler,
+		HealthHandler:   c.HealthHandler,
+		AuthMiddleware:  c.AuthMiddleware,
+		Idempotency:     c.Idempotency,
+		Logger:          c.Logger,
+		RateLimitRPS:    10.0, // 10 requests per second
+		RateLimitBurst:  20,   // Burst of 20 requests
+
+		// Public tracking is unauthenticated, so it gets a tighter per-IP limit
+		TrackingRateLimitRPS:   0.5, // one lookup every 2 seconds
+		TrackingRateLimitBurst: 5,
 	}
 	c.Router = http.NewRouter(routerConfig)
 	c.Router.SetupRoutes()
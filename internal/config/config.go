@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 type Config struct {
@@ -11,6 +12,8 @@ type Config struct {
 	Database DatabaseConfig
 	JWT      JWTConfig
 	Logger   LoggerConfig
+	Order    OrderConfig
+	Storage  StorageConfig
 }
 
 type ServerConfig struct {
@@ -36,12 +39,42 @@ type LoggerConfig struct {
 	Format string
 }
 
+type OrderConfig struct {
+	CancellationCutoffMinutes int
+	WorkflowConfigPath        string
+	MaxDeliveryAttempts       int
+	VolumetricDivisor         float64
+	PricingRatesPath          string
+	QuoteTTLMinutes           int
+	SLATimeZone               string
+	SLAPoliciesPath           string
+	SLAHolidays               []string
+	SLACutoffHour             int
+	SLADeliveryHour           int
+	ImportMaxRows             int
+	ImportSyncRows            int
+	ImportWorkers             int
+	ImportQueueSize           int
+	IdempotencyKeyTTLHours    int
+	IdempotencyLockMinutes    int
+	ArchiveAfterMonths        int
+	ArchiveIntervalHours      int
+	InsuranceRate             float64
+	InsuranceMaxCoverage      float64
+}
+
+type StorageConfig struct {
+	LocalPath string
+}
+
 func Load() (*Config, error) {
 	config := &Config{
 		Server:   loadServerConfig(),
 		Database: loadDatabaseConfig(),
 		JWT:      loadJWTConfig(),
 		Logger:   loadLoggerConfig(),
+		Order:    loadOrderConfig(),
+		Storage:  loadStorageConfig(),
 	}
 
 	if err := config.Validate(); err != nil {
@@ -82,6 +115,38 @@ func loadLoggerConfig() LoggerConfig {
 	}
 }
 
+func loadOrderConfig() OrderConfig {
+	return OrderConfig{
+		CancellationCutoffMinutes: getEnvInt("ORDER_CANCELLATION_CUTOFF_MINUTES", 60),
+		WorkflowConfigPath:        getEnv("ORDER_WORKFLOW_CONFIG_PATH", ""),
+		MaxDeliveryAttempts:       getEnvInt("ORDER_MAX_DELIVERY_ATTEMPTS", 3),
+		VolumetricDivisor:         getEnvFloat("ORDER_VOLUMETRIC_DIVISOR", 5000),
+		PricingRatesPath:          getEnv("ORDER_PRICING_RATES_PATH", ""),
+		QuoteTTLMinutes:           getEnvInt("ORDER_QUOTE_TTL_MINUTES", 60),
+		SLATimeZone:               getEnv("ORDER_SLA_TIME_ZONE", "UTC"),
+		SLAPoliciesPath:           getEnv("ORDER_SLA_POLICIES_PATH", ""),
+		SLAHolidays:               getEnvList("ORDER_SLA_HOLIDAYS"),
+		SLACutoffHour:             getEnvInt("ORDER_SLA_CUTOFF_HOUR", 14),
+		SLADeliveryHour:           getEnvInt("ORDER_SLA_DELIVERY_HOUR", 20),
+		ImportMaxRows:             getEnvInt("ORDER_IMPORT_MAX_ROWS", 5000),
+		ImportSyncRows:            getEnvInt("ORDER_IMPORT_SYNC_ROWS", 100),
+		ImportWorkers:             getEnvInt("ORDER_IMPORT_WORKERS", 2),
+		ImportQueueSize:           getEnvInt("ORDER_IMPORT_QUEUE_SIZE", 10),
+		IdempotencyKeyTTLHours:    getEnvInt("ORDER_IDEMPOTENCY_KEY_TTL_HOURS", 24),
+		IdempotencyLockMinutes:    getEnvInt("ORDER_IDEMPOTENCY_LOCK_TIMEOUT_MINUTES", 5),
+		ArchiveAfterMonths:        getEnvInt("ORDER_ARCHIVE_AFTER_MONTHS", 12),
+		ArchiveIntervalHours:      getEnvInt("ORDER_ARCHIVE_INTERVAL_HOURS", 24),
+		InsuranceRate:             getEnvFloat("ORDER_INSURANCE_RATE", 0.01),
+		InsuranceMaxCoverage:      getEnvFloat("ORDER_INSURANCE_MAX_COVERAGE", 5000),
+	}
+}
+
+func loadStorageConfig() StorageConfig {
+	return StorageConfig{
+		LocalPath: getEnv("STORAGE_LOCAL_PATH", "./data/uploads"),
+	}
+}
+
 func (c *Config) Validate() error {
 	if c.Database.DatabaseURL == "" {
 		return fmt.Errorf("DATABASE_URL is required")
@@ -103,6 +168,70 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("LOG_FORMAT must be either 'json' or 'text'")
 	}
 
+	if c.Order.CancellationCutoffMinutes < 0 {
+		return fmt.Errorf("ORDER_CANCELLATION_CUTOFF_MINUTES must not be negative")
+	}
+
+	if c.Order.MaxDeliveryAttempts < 1 {
+		return fmt.Errorf("ORDER_MAX_DELIVERY_ATTEMPTS must be at least 1")
+	}
+
+	if c.Order.VolumetricDivisor <= 0 {
+		return fmt.Errorf("ORDER_VOLUMETRIC_DIVISOR must be greater than 0")
+	}
+
+	if c.Order.SLACutoffHour < 0 || c.Order.SLACutoffHour > 23 {
+		return fmt.Errorf("ORDER_SLA_CUTOFF_HOUR must be between 0 and 23")
+	}
+
+	if c.Order.SLADeliveryHour < 0 || c.Order.SLADeliveryHour > 23 {
+		return fmt.Errorf("ORDER_SLA_DELIVERY_HOUR must be between 0 and 23")
+	}
+
+	if c.Order.QuoteTTLMinutes < 1 {
+		return fmt.Errorf("ORDER_QUOTE_TTL_MINUTES must be at least 1")
+	}
+
+	if c.Order.ImportMaxRows < 1 {
+		return fmt.Errorf("ORDER_IMPORT_MAX_ROWS must be at least 1")
+	}
+
+	if c.Order.ImportSyncRows < 0 {
+		return fmt.Errorf("ORDER_IMPORT_SYNC_ROWS must not be negative")
+	}
+
+	if c.Order.ImportWorkers < 1 {
+		return fmt.Errorf("ORDER_IMPORT_WORKERS must be at least 1")
+	}
+
+	if c.Order.ImportQueueSize < 1 {
+		return fmt.Errorf("ORDER_IMPORT_QUEUE_SIZE must be at least 1")
+	}
+
+	if c.Order.IdempotencyKeyTTLHours < 1 {
+		return fmt.Errorf("ORDER_IDEMPOTENCY_KEY_TTL_HOURS must be at least 1")
+	}
+
+	if c.Order.IdempotencyLockMinutes < 1 || c.Order.IdempotencyLockMinutes > c.Order.IdempotencyKeyTTLHours*60 {
+		return fmt.Errorf("ORDER_IDEMPOTENCY_LOCK_TIMEOUT_MINUTES must be at least 1 and at most the key TTL")
+	}
+
+	if c.Order.ArchiveAfterMonths < 0 {
+		return fmt.Errorf("ORDER_ARCHIVE_AFTER_MONTHS must not be negative")
+	}
+
+	if c.Order.ArchiveIntervalHours < 1 {
+		return fmt.Errorf("ORDER_ARCHIVE_INTERVAL_HOURS must be at least 1")
+	}
+
+	if c.Order.InsuranceRate <= 0 || c.Order.InsuranceRate > 1 {
+		return fmt.Errorf("ORDER_INSURANCE_RATE must be greater than 0 and at most 1")
+	}
+
+	if c.Order.InsuranceMaxCoverage <= 0 {
+		return fmt.Errorf("ORDER_INSURANCE_MAX_COVERAGE must be greater than 0")
+	}
+
 	return nil
 }
 
@@ -122,6 +251,26 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList splits a comma-separated variable, dropping empty entries.
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func (d *DatabaseConfig) GetDSN() string {
 	return d.DatabaseURL
 }
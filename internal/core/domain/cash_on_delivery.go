@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// DefaultCODCurrency is used when a cash-on-delivery amount comes without a
+// currency; most merchants collecting at the door sell in pesos.
+const DefaultCODCurrency = "MXN"
+
+// SetCashOnDelivery makes the order collect amount at the door.
+func (o *Order) SetCashOnDelivery(amount float64, currency string) error {
+	if amount <= 0 {
+		return errors.New("cash on delivery amount must be greater than 0")
+	}
+	if RoundAmount(amount) != amount {
+		return errors.New("cash on delivery amount can have at most 2 decimals")
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = DefaultCODCurrency
+	}
+	if len(currency) != 3 {
+		return errors.New("cash on delivery currency must be a 3-letter ISO code")
+	}
+
+	o.CODAmount = amount
+	o.CODCurrency = currency
+	return nil
+}
+
+func (o *Order) RequiresCashCollection() bool {
+	return o.CODAmount > 0
+}
+
+func (o *Order) IsCashCollected() bool {
+	return o.CODCollectedAt != nil
+}
+
+// CollectCash records what the driver got at the door. It is confirmed as
+// part of the delivery, so a mismatch is kept and flagged in reconciliation
+// instead of being rejected.
+func (o *Order) CollectCash(driverID string, amount float64, now time.Time) error {
+	if !o.RequiresCashCollection() {
+		return errors.New("order has no cash on delivery amount")
+	}
+	if o.IsCashCollected() {
+		return errors.New("cash on delivery was already collected")
+	}
+	if driverID == "" {
+		return errors.New("driver id is required")
+	}
+	if amount < 0 {
+		return errors.New("collected amount must not be negative")
+	}
+
+	collected := RoundAmount(amount)
+	o.CODCollectedAmount = &collected
+	o.CODCollectedAt = &now
+	o.CODDriverID = driverID
+	return nil
+}
+
+// CashDiscrepancy is the collected amount minus the expected one.
+func (o *Order) CashDiscrepancy() float64 {
+	if o.CODCollectedAmount == nil {
+		return 0
+	}
+	return RoundAmount(*o.CODCollectedAmount - o.CODAmount)
+}
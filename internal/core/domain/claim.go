@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ClaimType string
+
+const (
+	ClaimTypeLoss   ClaimType = "loss"
+	ClaimTypeDamage ClaimType = "damage"
+)
+
+type ClaimStatus string
+
+const (
+	ClaimSubmitted ClaimStatus = "submitted"
+	ClaimApproved  ClaimStatus = "approved"
+	ClaimRejected  ClaimStatus = "rejected"
+)
+
+var (
+	ErrClaimNotEligible     = errors.New("claims can only be filed for orders that have been picked up")
+	ErrClaimWithoutValue    = errors.New("claims require the order to have a declared value")
+	ErrClaimAlreadyResolved = errors.New("claim is already resolved")
+	ErrClaimAlreadyOpen     = errors.New("order already has a claim under review")
+)
+
+// Claim is a client's request for compensation over a lost or damaged
+// shipment. The declared value and insurance coverage are copied from the
+// order when it is filed, so later edits don't change what can be paid out.
+type Claim struct {
+	ID             string      `json:"id" gorm:"primaryKey"`
+	OrderID        string      `json:"order_id" gorm:"not null;index;uniqueIndex:idx_claims_open_order,where:status = 'submitted'"`
+	ClientID       string      `json:"client_id" gorm:"not null;index"`
+	Type           ClaimType   `json:"type" gorm:"not null"`
+	Description    string      `json:"description" gorm:"type:text;not null"`
+	ClaimedAmount  float64     `json:"claimed_amount" gorm:"not null"`
+	Currency       string      `json:"currency" gorm:"size:3;not null"`
+	DeclaredValue  float64     `json:"declared_value" gorm:"not null"`
+	Insured        bool        `json:"insured" gorm:"not null;default:false"`
+	Coverage       float64     `json:"coverage,omitempty"`
+	Status         ClaimStatus `json:"status" gorm:"not null;index"`
+	ApprovedAmount *float64    `json:"approved_amount,omitempty"`
+	ResolvedBy     string      `json:"resolved_by,omitempty"`
+	ResolutionNote string      `json:"resolution_note,omitempty" gorm:"type:text"`
+	CreatedAt      time.Time   `json:"created_at" gorm:"not null;index"`
+	ResolvedAt     *time.Time  `json:"resolved_at,omitempty"`
+}
+
+// NewClaim files a claim against the order's declared value. Orders that were
+// never picked up, or were cancelled, have nothing to claim.
+func NewClaim(order *Order, claimType ClaimType, description string, amount float64) (*Claim, error) {
+	switch order.Status {
+	case StatusCreated, StatusPendingQuote, StatusQuoted, StatusCancelled:
+		return nil, ErrClaimNotEligible
+	}
+
+	if order.DeclaredValue <= 0 {
+		return nil, ErrClaimWithoutValue
+	}
+
+	if claimType != ClaimTypeLoss && claimType != ClaimTypeDamage {
+		return nil, errors.New("invalid claim type")
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return nil, errors.New("claim description is required")
+	}
+
+	if amount <= 0 {
+		return nil, errors.New("claimed amount must be greater than 0")
+	}
+	if RoundAmount(amount) != amount {
+		return nil, errors.New("claimed amount can have at most 2 decimals")
+	}
+	if amount > order.DeclaredValue {
+		return nil, fmt.Errorf("claimed amount cannot exceed the declared value of %.2f", order.DeclaredValue)
+	}
+
+	claim := &Claim{
+		ID:            uuid.New().String(),
+		OrderID:       order.ID,
+		ClientID:      order.ClientID,
+		Type:          claimType,
+		Description:   description,
+		ClaimedAmount: amount,
+		Currency:      order.DeclaredValueCurrency,
+		DeclaredValue: order.DeclaredValue,
+		Insured:       order.Insured,
+		Status:        ClaimSubmitted,
+		CreatedAt:     time.Now(),
+	}
+	if order.Insured {
+		claim.Coverage = order.InsuranceCoverage
+	}
+
+	return claim, nil
+}
+
+func (c *Claim) IsOpen() bool {
+	return c.Status == ClaimSubmitted
+}
+
+// Approve settles the claim for at most the claimed amount and, on insured
+// orders, the insurance coverage.
+func (c *Claim) Approve(adminID string, amount float64, note string, now time.Time) error {
+	if !c.IsOpen() {
+		return ErrClaimAlreadyResolved
+	}
+
+	if amount <= 0 {
+		return errors.New("approved amount must be greater than 0")
+	}
+	if RoundAmount(amount) != amount {
+		return errors.New("approved amount can have at most 2 decimals")
+	}
+	if amount > c.ClaimedAmount {
+		return fmt.Errorf("approved amount cannot exceed the claimed amount of %.2f", c.ClaimedAmount)
+	}
+	if c.Insured && amount > c.Coverage {
+		return fmt.Errorf("approved amount cannot exceed the insurance coverage of %.2f", c.Coverage)
+	}
+
+	c.Status = ClaimApproved
+	c.ApprovedAmount = &amount
+	c.resolve(adminID, note, now)
+	return nil
+}
+
+func (c *Claim) Reject(adminID, note string, now time.Time) error {
+	if !c.IsOpen() {
+		return ErrClaimAlreadyResolved
+	}
+
+	if strings.TrimSpace(note) == "" {
+		return errors.New("a resolution note is required to reject a claim")
+	}
+
+	c.Status = ClaimRejected
+	c.resolve(adminID, note, now)
+	return nil
+}
+
+func (c *Claim) resolve(adminID, note string, now time.Time) {
+	c.ResolvedBy = adminID
+	c.ResolutionNote = strings.TrimSpace(note)
+	c.ResolvedAt = &now
+}
+
+func (c *Claim) CanBeViewedBy(userID string, userRole UserRole) bool {
+	return userRole == AdminRole || c.ClientID == userID
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// Contact is the person who hands over or receives the package at an
+// address, so drivers can call ahead.
+type Contact struct {
+	Name         string `json:"name" validate:"required,max=100"`
+	Phone        string `json:"phone" validate:"required,max=32"`
+	Email        string `json:"email,omitempty" validate:"omitempty,email,max=254"`
+	Instructions string `json:"instructions,omitempty" validate:"max=500" gorm:"type:text"`
+}
+
+// Normalize trims the fields and strips the spaces, dashes, dots and
+// parentheses people type into phone numbers.
+func (c *Contact) Normalize() {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Email = strings.ToLower(strings.TrimSpace(c.Email))
+	c.Instructions = strings.TrimSpace(c.Instructions)
+	c.Phone = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(c.Phone))
+}
+
+// Validate expects a normalized contact.
+func (c *Contact) Validate() error {
+	if c.Name == "" {
+		return errors.New("contact name is required")
+	}
+	if !e164Pattern.MatchString(c.Phone) {
+		return errors.New("contact phone must be in E.164 format, e.g. +5215512345678")
+	}
+	return nil
+}
+
+// IsSet reports whether the contact holds data. Orders loaded from the
+// database get an empty contact when none was stored.
+func (c *Contact) IsSet() bool {
+	return c != nil && c.Phone != ""
+}
+
+// Redacted keeps only what a public page may show: the first name with the
+// last name's initial and the last four digits of the phone.
+func (c *Contact) Redacted() Contact {
+	name := c.Name
+	if words := strings.Fields(c.Name); len(words) > 1 {
+		last, _ := utf8.DecodeRuneInString(words[len(words)-1])
+		name = words[0] + " " + string(last) + "."
+	}
+
+	phone := c.Phone
+	if len(phone) > 4 {
+		phone = "+" + strings.Repeat("*", len(phone)-5) + phone[len(phone)-4:]
+	}
+
+	return Contact{Name: name, Phone: phone}
+}
+
+// SetContacts records the sender and recipient; either may be nil.
+func (o *Order) SetContacts(sender, recipient *Contact) error {
+	if err := validateContact(sender, "sender"); err != nil {
+		return err
+	}
+	if err := validateContact(recipient, "recipient"); err != nil {
+		return err
+	}
+
+	if sender != nil {
+		o.SenderContact = sender
+	}
+	if recipient != nil {
+		o.RecipientContact = recipient
+	}
+	return nil
+}
+
+func validateContact(contact *Contact, role string) error {
+	if contact == nil {
+		return nil
+	}
+	contact.Normalize()
+	if err := contact.Validate(); err != nil {
+		return errors.New(role + " " + err.Error())
+	}
+	return nil
+}
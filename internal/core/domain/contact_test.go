@@ -0,0 +1,113 @@
+package domain
+
+import "testing"
+
+func TestContactNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Contact
+		want  Contact
+	}{
+		{
+			name:  "trims fields and lowercases email",
+			input: Contact{Name: "  Ana López ", Phone: " +5215512345678 ", Email: " Ana@Example.COM ", Instructions: " Ring twice "},
+			want:  Contact{Name: "Ana López", Phone: "+5215512345678", Email: "ana@example.com", Instructions: "Ring twice"},
+		},
+		{
+			name:  "strips phone punctuation",
+			input: Contact{Name: "Ana", Phone: "+52 (55) 1234-5678"},
+			want:  Contact{Name: "Ana", Phone: "+525512345678"},
+		},
+		{
+			name:  "strips dots from phone",
+			input: Contact{Name: "Ana", Phone: "+1.555.123.4567"},
+			want:  Contact{Name: "Ana", Phone: "+15551234567"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contact := tt.input
+			contact.Normalize()
+			if contact != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", contact, tt.want)
+			}
+		})
+	}
+}
+
+func TestContactValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		contact Contact
+		wantErr bool
+	}{
+		{name: "valid", contact: Contact{Name: "Ana López", Phone: "+5215512345678"}},
+		{name: "single word name", contact: Contact{Name: "Ana", Phone: "+5215512345678"}},
+		{name: "shortest phone", contact: Contact{Name: "Ana", Phone: "+1234567"}},
+		{name: "longest phone", contact: Contact{Name: "Ana", Phone: "+123456789012345"}},
+		{name: "missing name", contact: Contact{Phone: "+5215512345678"}, wantErr: true},
+		{name: "short phone", contact: Contact{Name: "Ana", Phone: "+123456"}, wantErr: true},
+		{name: "long phone", contact: Contact{Name: "Ana", Phone: "+1234567890123456"}, wantErr: true},
+		{name: "phone without plus", contact: Contact{Name: "Ana", Phone: "5215512345678"}, wantErr: true},
+		{name: "phone with leading zero", contact: Contact{Name: "Ana", Phone: "+0215512345678"}, wantErr: true},
+		{name: "phone with letters", contact: Contact{Name: "Ana", Phone: "+52155CALLME"}, wantErr: true},
+		{name: "empty phone", contact: Contact{Name: "Ana"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.contact.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestContactRedacted(t *testing.T) {
+	tests := []struct {
+		name    string
+		contact Contact
+		want    Contact
+	}{
+		{
+			name:    "first name and last initial",
+			contact: Contact{Name: "Ana López", Phone: "+5215512345678", Email: "ana@example.com", Instructions: "Gate 4"},
+			want:    Contact{Name: "Ana L.", Phone: "+*********5678"},
+		},
+		{
+			name:    "uses the last word as surname",
+			contact: Contact{Name: "Ana María López García", Phone: "+5215512345678"},
+			want:    Contact{Name: "Ana G.", Phone: "+*********5678"},
+		},
+		{
+			name:    "multibyte initial",
+			contact: Contact{Name: "Ana Ávila", Phone: "+5215512345678"},
+			want:    Contact{Name: "Ana Á.", Phone: "+*********5678"},
+		},
+		{
+			name:    "single word name kept",
+			contact: Contact{Name: "Ana", Phone: "+5215512345678"},
+			want:    Contact{Name: "Ana", Phone: "+*********5678"},
+		},
+		{
+			name:    "short phone keeps last four digits",
+			contact: Contact{Name: "Ana", Phone: "+1234567"},
+			want:    Contact{Name: "Ana", Phone: "+***4567"},
+		},
+		{
+			name:    "phone of four characters left as is",
+			contact: Contact{Name: "Ana", Phone: "1234"},
+			want:    Contact{Name: "Ana", Phone: "1234"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.contact.Redacted(); got != tt.want {
+				t.Errorf("Redacted() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
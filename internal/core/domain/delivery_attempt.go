@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeliveryFailureReason string
+
+const (
+	DeliveryFailureNoOneHome    DeliveryFailureReason = "no_one_home"
+	DeliveryFailureWrongAddress DeliveryFailureReason = "wrong_address"
+	DeliveryFailureRefused      DeliveryFailureReason = "refused"
+	DeliveryFailureInaccessible DeliveryFailureReason = "inaccessible"
+	DeliveryFailureOther        DeliveryFailureReason = "other"
+)
+
+type DeliveryAttempt struct {
+	ID            string                `json:"id" gorm:"primaryKey"`
+	OrderID       string                `json:"order_id" gorm:"not null;index"`
+	AttemptNumber int                   `json:"attempt_number" gorm:"not null"`
+	ReasonCode    DeliveryFailureReason `json:"reason_code" gorm:"not null"`
+	Notes         string                `json:"notes,omitempty"`
+	ActorID       string                `json:"actor_id" gorm:"not null"`
+	AttemptedAt   time.Time             `json:"attempted_at" gorm:"not null"`
+}
+
+func NewDeliveryAttempt(orderID string, attemptNumber int, reason DeliveryFailureReason, notes, actorID string) (*DeliveryAttempt, error) {
+	if orderID == "" {
+		return nil, errors.New("order id is required")
+	}
+
+	if attemptNumber <= 0 {
+		return nil, errors.New("attempt number must be greater than 0")
+	}
+
+	if !IsValidDeliveryFailureReason(reason) {
+		return nil, errors.New("invalid delivery failure reason")
+	}
+
+	if actorID == "" {
+		return nil, errors.New("actor id is required")
+	}
+
+	return &DeliveryAttempt{
+		ID:            uuid.New().String(),
+		OrderID:       orderID,
+		AttemptNumber: attemptNumber,
+		ReasonCode:    reason,
+		Notes:         notes,
+		ActorID:       actorID,
+		AttemptedAt:   time.Now(),
+	}, nil
+}
+
+func IsValidDeliveryFailureReason(reason DeliveryFailureReason) bool {
+	for _, valid := range GetValidDeliveryFailureReasons() {
+		if valid == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func GetValidDeliveryFailureReasons() []DeliveryFailureReason {
+	return []DeliveryFailureReason{
+		DeliveryFailureNoOneHome, DeliveryFailureWrongAddress, DeliveryFailureRefused,
+		DeliveryFailureInaccessible, DeliveryFailureOther,
+	}
+}
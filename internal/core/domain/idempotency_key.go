@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxIdempotencyKeyLength bounds the Idempotency-Key header.
+const MaxIdempotencyKeyLength = 255
+
+var (
+	ErrIdempotencyKeyInUse = errors.New("idempotency key already exists")
+	ErrIdempotencyKeyLost  = errors.New("idempotency key was taken over by another request")
+)
+
+// IdempotencyKey remembers the outcome of a request so a retry with the same
+// key gets the same response instead of repeating the work. A key without a
+// status code is still being processed; once LockedUntil passes, the request
+// holding it is presumed dead and a retry may take the key over.
+type IdempotencyKey struct {
+	ClientID     string     `json:"client_id" gorm:"primaryKey"`
+	Key          string     `json:"key" gorm:"primaryKey;size:255"`
+	RequestHash  string     `json:"request_hash" gorm:"not null"`
+	StatusCode   int        `json:"status_code"`
+	ResponseBody []byte     `json:"-"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	LockedUntil  time.Time  `json:"locked_until"`
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null;index"`
+}
+
+func NewIdempotencyKey(clientID, key, requestHash string, now time.Time, ttl, lockTimeout time.Duration) (*IdempotencyKey, error) {
+	if clientID == "" {
+		return nil, errors.New("client id is required")
+	}
+
+	if key == "" || len(key) > MaxIdempotencyKeyLength {
+		return nil, errors.New("idempotency key must be between 1 and 255 characters")
+	}
+
+	// CreatedAt identifies this claim on later writes, so it must survive the
+	// round trip through the database's microsecond timestamps.
+	now = now.Truncate(time.Microsecond)
+
+	return &IdempotencyKey{
+		ClientID:    clientID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		LockedUntil: now.Add(lockTimeout),
+		ExpiresAt:   now.Add(ttl),
+	}, nil
+}
+
+func (k *IdempotencyKey) Complete(statusCode int, body []byte, now time.Time) {
+	k.StatusCode = statusCode
+	k.ResponseBody = body
+	k.CompletedAt = &now
+}
+
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.CompletedAt != nil
+}
+
+func (k *IdempotencyKey) IsExpired(now time.Time) bool {
+	return !now.Before(k.ExpiresAt)
+}
+
+// IsReclaimable reports whether another request may replace the key: it
+// expired, or it was never completed and its lock timed out.
+func (k *IdempotencyKey) IsReclaimable(now time.Time) bool {
+	return k.IsExpired(now) || (!k.IsCompleted() && !now.Before(k.LockedUntil))
+}
+
+func (k *IdempotencyKey) Matches(requestHash string) bool {
+	return k.RequestHash == requestHash
+}
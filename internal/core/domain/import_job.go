@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrImportJobNotFound = errors.New("import job not found")
+
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"
+	ImportJobRunning   ImportJobStatus = "running"
+	ImportJobCompleted ImportJobStatus = "completed"
+	ImportJobFailed    ImportJobStatus = "failed"
+)
+
+// ImportRowResult reports the outcome of one row of a bulk import. Row is
+// 1-based and counts data rows only, not the CSV header.
+type ImportRowResult struct {
+	Row          int    `json:"row"`
+	Success      bool   `json:"success"`
+	OrderID      string `json:"order_id,omitempty"`
+	TrackingCode string `json:"tracking_code,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+type ImportJob struct {
+	ID         string            `json:"id" gorm:"primaryKey"`
+	ClientID   string            `json:"client_id" gorm:"not null;index"`
+	Status     ImportJobStatus   `json:"status" gorm:"not null"`
+	TotalRows  int               `json:"total_rows" gorm:"not null"`
+	Succeeded  int               `json:"succeeded" gorm:"not null;default:0"`
+	Failed     int               `json:"failed" gorm:"not null;default:0"`
+	Results    []ImportRowResult `json:"results" gorm:"type:jsonb;serializer:json"`
+	Error      string            `json:"error,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	StartedAt  *time.Time        `json:"started_at,omitempty"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty"`
+}
+
+func NewImportJob(clientID string, totalRows int) (*ImportJob, error) {
+	if clientID == "" {
+		return nil, errors.New("client id is required")
+	}
+
+	if totalRows < 1 {
+		return nil, errors.New("import must contain at least one row")
+	}
+
+	return &ImportJob{
+		ID:        uuid.New().String(),
+		ClientID:  clientID,
+		Status:    ImportJobPending,
+		TotalRows: totalRows,
+		Results:   make([]ImportRowResult, 0, totalRows),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (j *ImportJob) Start(now time.Time) {
+	j.Status = ImportJobRunning
+	j.StartedAt = &now
+}
+
+func (j *ImportJob) Record(result ImportRowResult) {
+	if result.Success {
+		j.Succeeded++
+	} else {
+		j.Failed++
+	}
+	j.Results = append(j.Results, result)
+}
+
+func (j *ImportJob) Processed() int {
+	return j.Succeeded + j.Failed
+}
+
+func (j *ImportJob) Finish(now time.Time) {
+	j.Status = ImportJobCompleted
+	j.FinishedAt = &now
+}
+
+// Abort marks the job as failed; rows already recorded keep their results.
+func (j *ImportJob) Abort(reason string, now time.Time) {
+	j.Status = ImportJobFailed
+	j.Error = reason
+	j.FinishedAt = &now
+}
+
+func (j *ImportJob) IsFinished() bool {
+	return j.Status == ImportJobCompleted || j.Status == ImportJobFailed
+}
+
+func (j *ImportJob) CanBeViewedBy(userID string, userRole UserRole) bool {
+	return userRole == AdminRole || j.ClientID == userID
+}
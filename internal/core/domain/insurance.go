@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// InsurancePolicy prices opt-in shipment insurance: the premium is Rate times
+// the declared value, which is covered up to MaxCoverage.
+type InsurancePolicy struct {
+	Rate        float64
+	MaxCoverage float64
+}
+
+func (p InsurancePolicy) Validate() error {
+	if p.Rate <= 0 || p.Rate > 1 {
+		return errors.New("insurance rate must be greater than 0 and at most 1")
+	}
+	if p.MaxCoverage <= 0 {
+		return errors.New("insurance max coverage must be greater than 0")
+	}
+	return nil
+}
+
+// Cover returns the insured amount and its premium for a declared value.
+func (p InsurancePolicy) Cover(declaredValue float64) (coverage, premium float64) {
+	coverage = declaredValue
+	if coverage > p.MaxCoverage {
+		coverage = p.MaxCoverage
+	}
+	return RoundAmount(coverage), RoundAmount(coverage * p.Rate)
+}
+
+// AddInsurance adds the premium to the quote as its own line item.
+func (q *PriceQuote) AddInsurance(coverage, premium float64) {
+	q.Items = append(q.Items, PriceLineItem{
+		Code:        "insurance",
+		Description: fmt.Sprintf("Insurance for %.2f %s", coverage, q.Currency),
+		Amount:      premium,
+	})
+	q.Total = RoundAmount(q.Total + premium)
+}
+
+// DeclareValue records what the goods are worth. The currency may be left
+// empty and filled in with the price currency once the order is priced.
+func (o *Order) DeclareValue(value float64, currency string) error {
+	if value <= 0 {
+		return errors.New("declared value must be greater than 0")
+	}
+	if RoundAmount(value) != value {
+		return errors.New("declared value can have at most 2 decimals")
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency != "" && len(currency) != 3 {
+		return errors.New("declared value currency must be a 3-letter ISO code")
+	}
+
+	o.DeclaredValue = value
+	o.DeclaredValueCurrency = currency
+	return nil
+}
+
+// Insure opts the order into insurance for its declared value.
+func (o *Order) Insure(policy InsurancePolicy) error {
+	if o.DeclaredValue <= 0 {
+		return errors.New("insurance requires a declared value")
+	}
+
+	o.Insured = true
+	o.InsuranceCoverage, o.InsurancePremium = policy.Cover(o.DeclaredValue)
+	return nil
+}
+
+// checkInsuranceCurrency makes sure the premium can be charged in the price
+// currency, adopting it when the declared value came without one.
+func (o *Order) checkInsuranceCurrency(priceCurrency string) error {
+	if o.DeclaredValue <= 0 {
+		return nil
+	}
+	if o.DeclaredValueCurrency == "" {
+		o.DeclaredValueCurrency = priceCurrency
+		return nil
+	}
+	if o.Insured && o.DeclaredValueCurrency != priceCurrency {
+		return fmt.Errorf("insured declared value must be in the price currency %s", priceCurrency)
+	}
+	return nil
+}
+
+// ApplyQuote sets the order price from a quote, adding the insurance premium
+// for insured orders.
+func (o *Order) ApplyQuote(quote *PriceQuote) error {
+	if err := o.checkInsuranceCurrency(quote.Currency); err != nil {
+		return err
+	}
+	if o.Insured {
+		quote.AddInsurance(o.InsuranceCoverage, o.InsurancePremium)
+	}
+
+	o.Price = quote.Total
+	o.Currency = quote.Currency
+	return nil
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,6 +10,7 @@ import (
 
 type OrderStatus string
 type PackageSize string
+type CancellationReason string
 
 const (
 	StatusCreated   OrderStatus = "creado"
@@ -17,11 +19,24 @@ const (
 	StatusInRoute   OrderStatus = "en_ruta"
 	StatusDelivered OrderStatus = "entregado"
 	StatusCancelled OrderStatus = "cancelado"
+	StatusReturning OrderStatus = "en_devolucion"
+	StatusReturned  OrderStatus = "devuelto"
+
+	StatusPartiallyDelivered OrderStatus = "entregado_parcial"
+
+	StatusPendingQuote OrderStatus = "pendiente_cotizacion"
+	StatusQuoted       OrderStatus = "cotizado"
 
 	PackageSizeS       PackageSize = "S"
 	PackageSizeM       PackageSize = "M"
 	PackageSizeL       PackageSize = "L"
 	PackageSizeSpecial PackageSize = "SPECIAL"
+
+	CancellationReasonChangedMind   CancellationReason = "changed_mind"
+	CancellationReasonDuplicate     CancellationReason = "duplicate_order"
+	CancellationReasonWrongAddress  CancellationReason = "wrong_address"
+	CancellationReasonPickupDelayed CancellationReason = "pickup_delayed"
+	CancellationReasonOther         CancellationReason = "other"
 )
 
 type Coordinates struct {
@@ -29,21 +44,76 @@ type Coordinates struct {
 	Longitude float64 `json:"longitude" validate:"required,min=-180,max=180" gorm:"not null"`
 }
 
+type Dimensions struct {
+	LengthCm float64 `json:"length_cm" validate:"required,gt=0"`
+	WidthCm  float64 `json:"width_cm" validate:"required,gt=0"`
+	HeightCm float64 `json:"height_cm" validate:"required,gt=0"`
+}
+
+func (d Dimensions) Validate() error {
+	if d.LengthCm <= 0 || d.WidthCm <= 0 || d.HeightCm <= 0 {
+		return errors.New("length, width and height must be greater than 0")
+	}
+	return nil
+}
+
+// VolumetricWeight returns the dimensional weight in kg for the given divisor
+// (cm³ per kg).
+func (d Dimensions) VolumetricWeight(divisor float64) float64 {
+	return math.Round(d.LengthCm*d.WidthCm*d.HeightCm/divisor*100) / 100
+}
+
 type Order struct {
-	ID                 string      `json:"id" gorm:"primaryKey"`
-	ClientID           string      `json:"client_id" gorm:"not null;index"`
-	OriginCoords       Coordinates `json:"origin_coordinates" gorm:"embedded;embeddedPrefix:origin_"`
-	DestinationCoords  Coordinates `json:"destination_coordinates" gorm:"embedded;embeddedPrefix:destination_"`
-	OriginAddress      Address     `json:"origin_address" gorm:"embedded;embeddedPrefix:origin_addr_"`
-	DestinationAddress Address     `json:"destination_address" gorm:"embedded;embeddedPrefix:dest_addr_"`
-	ProductQuantity    int         `json:"product_quantity" gorm:"not null" validate:"required,min=1"`
-	TotalWeight        float64     `json:"total_weight" gorm:"not null" validate:"required,min=0.1"`
-	PackageSize        PackageSize `json:"package_size" gorm:"not null"`
-	Status             OrderStatus `json:"status" gorm:"not null;default:'creado'"`
-	CreatedAt          time.Time   `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt          time.Time   `json:"updated_at" gorm:"autoUpdateTime"`
-
-	Client User `json:"client,omitempty" gorm:"foreignKey:ClientID"`
+	ID                    string      `json:"id" gorm:"primaryKey;index:idx_orders_created_at_id,priority:2"`
+	ClientID              string      `json:"client_id" gorm:"not null;index"`
+	TrackingCode          string      `json:"tracking_code" gorm:"uniqueIndex;size:20"`
+	OriginCoords          Coordinates `json:"origin_coordinates" gorm:"embedded;embeddedPrefix:origin_"`
+	DestinationCoords     Coordinates `json:"destination_coordinates" gorm:"embedded;embeddedPrefix:destination_"`
+	OriginAddress         Address     `json:"origin_address" gorm:"embedded;embeddedPrefix:origin_addr_"`
+	DestinationAddress    Address     `json:"destination_address" gorm:"embedded;embeddedPrefix:dest_addr_"`
+	SenderContact         *Contact    `json:"sender_contact,omitempty" gorm:"embedded;embeddedPrefix:sender_"`
+	RecipientContact      *Contact    `json:"recipient_contact,omitempty" gorm:"embedded;embeddedPrefix:recipient_"`
+	ProductQuantity       int         `json:"product_quantity" gorm:"not null" validate:"required,min=1"`
+	TotalWeight           float64     `json:"total_weight" gorm:"not null" validate:"required,min=0.1"`
+	Dimensions            *Dimensions `json:"dimensions,omitempty" gorm:"embedded;embeddedPrefix:dim_"`
+	VolumetricWeight      float64     `json:"volumetric_weight"`
+	BillableWeight        float64     `json:"billable_weight"`
+	PackageSize           PackageSize `json:"package_size" gorm:"not null"`
+	ServiceType           ServiceType `json:"service_type" gorm:"not null;default:'standard'"`
+	Status                OrderStatus `json:"status" gorm:"not null;default:'creado'"`
+	DeliveryAttempts      int         `json:"delivery_attempts" gorm:"not null;default:0"`
+	Price                 float64     `json:"price,omitempty"`
+	Currency              string      `json:"currency,omitempty"`
+	QuoteID               string      `json:"quote_id,omitempty"`
+	HandlingNotes         string      `json:"handling_notes,omitempty"`
+	DeclaredValue         float64     `json:"declared_value,omitempty"`
+	DeclaredValueCurrency string      `json:"declared_value_currency,omitempty" gorm:"size:3"`
+	Insured               bool        `json:"insured" gorm:"not null;default:false"`
+	InsuranceCoverage     float64     `json:"insurance_coverage,omitempty"`
+	InsurancePremium      float64     `json:"insurance_premium,omitempty"`
+	CODAmount             float64     `json:"cod_amount,omitempty" gorm:"column:cod_amount"`
+	CODCurrency           string      `json:"cod_currency,omitempty" gorm:"column:cod_currency;size:3"`
+	CODCollectedAmount    *float64    `json:"cod_collected_amount,omitempty" gorm:"column:cod_collected_amount"`
+	CODCollectedAt        *time.Time  `json:"cod_collected_at,omitempty" gorm:"column:cod_collected_at;index"`
+	CODDriverID           string      `json:"cod_driver_id,omitempty" gorm:"column:cod_driver_id;index"`
+	QuotedAt              *time.Time  `json:"quoted_at,omitempty"`
+	QuoteAcceptedAt       *time.Time  `json:"quote_accepted_at,omitempty"`
+	PickupWindow          *TimeWindow `json:"pickup_window,omitempty" gorm:"embedded;embeddedPrefix:pickup_"`
+	DeliveryWindow        *TimeWindow `json:"delivery_window,omitempty" gorm:"embedded;embeddedPrefix:delivery_"`
+	PromisedDeliveryAt    *time.Time  `json:"promised_delivery_at,omitempty" gorm:"index"`
+	ClosedAt              *time.Time  `json:"closed_at,omitempty" gorm:"index"`
+	Version               int         `json:"version" gorm:"not null;default:1"`
+	CreatedAt             time.Time   `json:"created_at" gorm:"autoCreateTime;index:idx_orders_created_at_id,priority:1"`
+	UpdatedAt             time.Time   `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt             *time.Time  `json:"deleted_at,omitempty" gorm:"index"`
+	DeletedBy             string      `json:"deleted_by,omitempty"`
+	DeletionReason        string      `json:"deletion_reason,omitempty"`
+
+	Client          User             `json:"client,omitempty" gorm:"foreignKey:ClientID"`
+	Parcels         []Parcel         `json:"parcels,omitempty" gorm:"foreignKey:OrderID"`
+	ProofOfDelivery *ProofOfDelivery `json:"proof_of_delivery,omitempty" gorm:"foreignKey:OrderID"`
+
+	failedAttempt *DeliveryAttempt
 }
 
 func NewOrder(
@@ -51,7 +121,9 @@ func NewOrder(
 	originCoords, destCoords Coordinates,
 	originAddr, destAddr Address,
 	productQuantity int,
-	totalWeight float64,
+	parcelSpecs []ParcelSpec,
+	volumetricDivisor float64,
+	serviceType ServiceType,
 ) (*Order, error) {
 
 	if err := validateCoordinates(originCoords); err != nil {
@@ -66,61 +138,269 @@ func NewOrder(
 		return nil, errors.New("product quantity must be greater than 0")
 	}
 
-	if totalWeight <= 0 {
-		return nil, errors.New("total weight must be greater than 0")
+	if len(parcelSpecs) == 0 {
+		return nil, errors.New("at least one parcel is required")
 	}
 
-	packageSize := determinePackageSize(totalWeight)
+	if serviceType == "" {
+		serviceType = ServiceTypeStandard
+	}
+
+	if !IsValidServiceType(serviceType) {
+		return nil, errors.New("invalid service type")
+	}
+
+	if volumetricDivisor <= 0 {
+		return nil, errors.New("volumetric divisor must be greater than 0")
+	}
+
+	orderID := uuid.New().String()
+	parcels := make([]Parcel, len(parcelSpecs))
+	var totalWeight, volumetricWeight, billableWeight float64
+	for i, spec := range parcelSpecs {
+		parcel, err := NewParcel(orderID, i+1, spec, volumetricDivisor, StatusCreated)
+		if err != nil {
+			return nil, err
+		}
+		parcels[i] = *parcel
+		totalWeight += parcel.Weight
+		volumetricWeight += parcel.VolumetricWeight
+		billableWeight += parcel.BillableWeight
+	}
+
+	var dimensions *Dimensions
+	if len(parcels) == 1 {
+		dimensions = parcels[0].Dimensions
+	}
+
+	packageSize := determinePackageSize(billableWeight)
+
+	// Special packages need a manual quote before they enter the normal flow
+	status := StatusCreated
 	if packageSize == PackageSizeSpecial {
-		return nil, errors.New("weight exceeds standard service limit. Please contact us for special arrangements")
+		status = StatusPendingQuote
+	}
+
+	for i := range parcels {
+		parcels[i].Status = status
+	}
+
+	trackingCode, err := GenerateTrackingCode()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range parcels {
+		parcels[i].TrackingCode, err = ParcelTrackingCode(trackingCode, parcels[i].Sequence)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &Order{
-		ID:                 uuid.New().String(),
+		ID:                 orderID,
 		ClientID:           clientID,
+		TrackingCode:       trackingCode,
 		OriginCoords:       originCoords,
 		DestinationCoords:  destCoords,
 		OriginAddress:      originAddr,
 		DestinationAddress: destAddr,
 		ProductQuantity:    productQuantity,
 		TotalWeight:        totalWeight,
+		Dimensions:         dimensions,
+		VolumetricWeight:   volumetricWeight,
+		BillableWeight:     billableWeight,
 		PackageSize:        packageSize,
-		Status:             StatusCreated,
+		ServiceType:        serviceType,
+		Status:             status,
+		Version:            1,
 		CreatedAt:          time.Now(),
 		UpdatedAt:          time.Now(),
+		Parcels:            parcels,
 	}, nil
 }
 
-func (o *Order) UpdateStatus(newStatus OrderStatus) error {
-	validTransitions := map[OrderStatus][]OrderStatus{
-		StatusCreated:   {StatusCollected, StatusCancelled},
-		StatusCollected: {StatusAtStation, StatusCancelled},
-		StatusAtStation: {StatusInRoute, StatusCancelled},
-		StatusInRoute:   {StatusDelivered, StatusCancelled},
-		StatusDelivered: {},
-		StatusCancelled: {},
+func (o *Order) TransitionTo(workflow *Workflow, newStatus OrderStatus, role UserRole) error {
+	if workflow == nil {
+		return errors.New("workflow is required")
 	}
 
-	allowedStatuses, exists := validTransitions[o.Status]
-	if !exists {
+	if !workflow.HasState(o.Status) {
 		return errors.New("invalid current status")
 	}
 
-	for _, allowed := range allowedStatuses {
-		if allowed == newStatus {
-			o.Status = newStatus
-			o.UpdatedAt = time.Now()
-			return nil
+	transition, exists := workflow.FindTransition(o.Status, newStatus)
+	if !exists {
+		return errors.New("invalid status transition from " + string(o.Status) + " to " + string(newStatus))
+	}
+
+	if !transition.AllowsRole(role) {
+		return ErrTransitionNotPermitted
+	}
+
+	if err := o.checkGuards(transition); err != nil {
+		return err
+	}
+
+	o.setStatus(newStatus, time.Now())
+	o.propagateStatusToParcels()
+	return nil
+}
+
+// setStatus records the status and, when it closes the order, when that
+// happened. Archival ages orders from ClosedAt, since later edits such as a
+// soft delete also move UpdatedAt.
+func (o *Order) setStatus(status OrderStatus, now time.Time) {
+	o.Status = status
+	o.UpdatedAt = now
+	if status.IsClosed() {
+		o.ClosedAt = &now
+	} else {
+		o.ClosedAt = nil
+	}
+}
+
+func (o *Order) checkGuards(transition WorkflowTransition) error {
+	for _, name := range transition.Guards {
+		guard, ok := workflowGuards[name]
+		if !ok {
+			return errors.New("unknown workflow guard " + name)
+		}
+		if err := guard(o); err != nil {
+			return errors.New("transition to " + string(transition.To) + " not allowed: " + err.Error())
 		}
 	}
+	return nil
+}
+
+// RecordFailedDelivery registers a failed attempt for an order that is out for
+// delivery. The order goes back to the station for a retry until maxAttempts
+// is reached, after which the return-to-sender leg starts.
+func (o *Order) RecordFailedDelivery(workflow *Workflow, role UserRole, actorID string, reason DeliveryFailureReason, notes string, maxAttempts int) (*DeliveryAttempt, error) {
+	if o.Status != StatusInRoute {
+		return nil, errors.New("failed deliveries can only be recorded while in status " + string(StatusInRoute))
+	}
 
-	return errors.New("invalid status transition from " + string(o.Status) + " to " + string(newStatus))
+	attempt, err := NewDeliveryAttempt(o.ID, o.DeliveryAttempts+1, reason, notes, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	nextStatus := StatusAtStation
+	if attempt.AttemptNumber >= maxAttempts {
+		nextStatus = StatusReturning
+	}
+
+	o.failedAttempt = attempt
+	defer func() { o.failedAttempt = nil }()
+
+	if err := o.TransitionTo(workflow, nextStatus, role); err != nil {
+		return nil, err
+	}
+
+	o.DeliveryAttempts = attempt.AttemptNumber
+	return attempt, nil
+}
+
+func (o *Order) AttachQuote(workflow *Workflow, role UserRole, price float64, currency, handlingNotes string) error {
+	if o.Status != StatusPendingQuote {
+		return errors.New("quotes can only be attached while in status " + string(StatusPendingQuote))
+	}
+
+	if price <= 0 {
+		return errors.New("quoted price must be greater than 0")
+	}
+
+	if len(currency) != 3 {
+		return errors.New("currency must be a 3-letter ISO code")
+	}
+
+	if err := o.checkInsuranceCurrency(currency); err != nil {
+		return err
+	}
+
+	if err := o.TransitionTo(workflow, StatusQuoted, role); err != nil {
+		return err
+	}
+
+	if o.Insured {
+		price = RoundAmount(price + o.InsurancePremium)
+	}
+
+	now := time.Now()
+	o.Price = price
+	o.Currency = currency
+	o.HandlingNotes = handlingNotes
+	o.QuotedAt = &now
+	return nil
+}
+
+func (o *Order) AcceptQuote(workflow *Workflow) error {
+	if o.Status != StatusQuoted {
+		return errors.New("only quoted orders can be accepted")
+	}
+
+	now := time.Now()
+	o.QuoteAcceptedAt = &now
+	if err := o.TransitionTo(workflow, StatusCreated, ClientRole); err != nil {
+		o.QuoteAcceptedAt = nil
+		return err
+	}
+	return nil
+}
+
+func (o *Order) RejectQuote(workflow *Workflow) error {
+	if o.Status != StatusQuoted {
+		return errors.New("only quoted orders can be rejected")
+	}
+
+	return o.TransitionTo(workflow, StatusCancelled, ClientRole)
+}
+
+func (o *Order) IsReturningToSender() bool {
+	return o.Status == StatusReturning || o.Status == StatusReturned
+}
+
+// ReturnAddress is where the package goes on the return-to-sender leg.
+func (o *Order) ReturnAddress() Address {
+	return o.OriginAddress
+}
+
+// CancelByClient cancels the order from any status its workflow lets the
+// client cancel, unless the pickup cutoff has passed.
+func (o *Order) CancelByClient(workflow *Workflow, now time.Time, cutoff time.Duration) error {
+	if workflow == nil {
+		return errors.New("workflow is required")
+	}
+
+	if transition, ok := workflow.FindTransition(o.Status, StatusCancelled); !ok || !transition.AllowsRole(ClientRole) {
+		return errors.New("order cannot be cancelled by the client while in status " + string(o.Status))
+	}
+
+	if pickupAt := o.scheduledPickupAt(); pickupAt != nil && !now.Before(pickupAt.Add(-cutoff)) {
+		return errors.New("cancellation cutoff before pickup has passed")
+	}
+
+	return o.TransitionTo(workflow, StatusCancelled, ClientRole)
+}
+
+// scheduledPickupAt returns when the pickup is planned, or nil when the order
+// has no pickup schedule and only the workflow applies.
+func (o *Order) scheduledPickupAt() *time.Time {
+	if !o.PickupWindow.IsSet() {
+		return nil
+	}
+	return &o.PickupWindow.Start
 }
 
 func (o *Order) CanBeModifiedBy(userRole UserRole) bool {
 	return userRole == AdminRole
 }
 
+func (o *Order) CanBeViewedBy(userID string, userRole UserRole) bool {
+	return userRole == AdminRole || o.ClientID == userID
+}
+
 func validateCoordinates(coords Coordinates) error {
 	if coords.Latitude < -90 || coords.Latitude > 90 {
 		return errors.New("latitude must be between -90 and 90")
@@ -148,6 +428,24 @@ func GetValidStatuses() []OrderStatus {
 	return []OrderStatus{
 		StatusCreated, StatusCollected, StatusAtStation,
 		StatusInRoute, StatusDelivered, StatusCancelled,
+		StatusReturning, StatusReturned,
+		StatusPartiallyDelivered, StatusPendingQuote, StatusQuoted,
+	}
+}
+
+func IsValidStatus(status OrderStatus) bool {
+	for _, valid := range GetValidStatuses() {
+		if valid == status {
+			return true
+		}
+	}
+	return false
+}
+
+func GetValidCancellationReasons() []CancellationReason {
+	return []CancellationReason{
+		CancellationReasonChangedMind, CancellationReasonDuplicate, CancellationReasonWrongAddress,
+		CancellationReasonPickupDelayed, CancellationReasonOther,
 	}
 }
 
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	ErrOrderDeleted     = errors.New("order is already deleted")
+	ErrOrderNotDeleted  = errors.New("order is not deleted")
+	ErrOrderInOperation = errors.New("orders being picked up, moved or returned cannot be deleted")
+)
+
+// SoftDelete hides the order from every listing and lookup while keeping the
+// row, so it can be restored. Orders on the road can't be deleted.
+func (o *Order) SoftDelete(actorID, reason string, now time.Time) error {
+	if o.IsDeleted() {
+		return ErrOrderDeleted
+	}
+
+	switch o.Status {
+	case StatusCollected, StatusAtStation, StatusInRoute, StatusPartiallyDelivered, StatusReturning:
+		return ErrOrderInOperation
+	}
+
+	if reason == "" {
+		return errors.New("deletion reason is required")
+	}
+
+	o.DeletedAt = &now
+	o.DeletedBy = actorID
+	o.DeletionReason = reason
+	return nil
+}
+
+func (o *Order) Restore() error {
+	if !o.IsDeleted() {
+		return ErrOrderNotDeleted
+	}
+
+	o.DeletedAt = nil
+	o.DeletedBy = ""
+	o.DeletionReason = ""
+	return nil
+}
+
+func (o *Order) IsDeleted() bool {
+	return o.DeletedAt != nil
+}
+
+// GetArchivableStatuses lists the statuses whose orders are moved to the
+// archive once they are old enough.
+func GetArchivableStatuses() []OrderStatus {
+	return []OrderStatus{StatusDelivered, StatusCancelled}
+}
+
+// IsClosed reports whether the status ends the order's lifecycle.
+func (s OrderStatus) IsClosed() bool {
+	for _, closed := range GetArchivableStatuses() {
+		if s == closed {
+			return true
+		}
+	}
+	return false
+}
+
+// ArchivedOrder keeps a closed order after it leaves the orders table. The
+// searchable fields are copied into columns; everything else, including the
+// status history, delivery attempts, notes and claims, lives in the JSON snapshot.
+type ArchivedOrder struct {
+	ID              string      `json:"id" gorm:"primaryKey"`
+	TrackingCode    string      `json:"tracking_code" gorm:"index"`
+	ClientID        string      `json:"client_id" gorm:"not null;index"`
+	Status          OrderStatus `json:"status" gorm:"not null"`
+	ServiceType     ServiceType `json:"service_type"`
+	PackageSize     PackageSize `json:"package_size"`
+	OriginCity      string      `json:"origin_city"`
+	DestinationCity string      `json:"destination_city"`
+	TotalWeight     float64     `json:"total_weight"`
+	Price           float64     `json:"price,omitempty"`
+	Currency        string      `json:"currency,omitempty"`
+	OrderCreatedAt  time.Time   `json:"order_created_at" gorm:"not null;index"`
+	ClosedAt        time.Time   `json:"closed_at"`
+	DeletedAt       *time.Time  `json:"deleted_at,omitempty"`
+	ArchivedAt      time.Time   `json:"archived_at" gorm:"not null"`
+	Snapshot        []byte      `json:"-" gorm:"type:jsonb;not null"`
+}
+
+type ArchivedOrderSnapshot struct {
+	Order            *Order              `json:"order"`
+	Events           []*OrderStatusEvent `json:"events"`
+	DeliveryAttempts []*DeliveryAttempt  `json:"delivery_attempts"`
+	Notes            []*OrderNote        `json:"notes"`
+	Claims           []*Claim            `json:"claims"`
+}
+
+func NewArchivedOrder(order *Order, events []*OrderStatusEvent, attempts []*DeliveryAttempt, notes []*OrderNote, claims []*Claim, now time.Time) (*ArchivedOrder, error) {
+	if order.ClosedAt == nil {
+		return nil, errors.New("only closed orders can be archived")
+	}
+	closedAt := *order.ClosedAt
+
+	snapshotOrder := *order
+	snapshotOrder.Client = User{}
+
+	snapshot, err := json.Marshal(ArchivedOrderSnapshot{
+		Order:            &snapshotOrder,
+		Events:           events,
+		DeliveryAttempts: attempts,
+		Notes:            notes,
+		Claims:           claims,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArchivedOrder{
+		ID:              order.ID,
+		TrackingCode:    order.TrackingCode,
+		ClientID:        order.ClientID,
+		Status:          order.Status,
+		ServiceType:     order.ServiceType,
+		PackageSize:     order.PackageSize,
+		OriginCity:      order.OriginAddress.City,
+		DestinationCity: order.DestinationAddress.City,
+		TotalWeight:     order.TotalWeight,
+		Price:           order.Price,
+		Currency:        order.Currency,
+		OrderCreatedAt:  order.CreatedAt,
+		ClosedAt:        closedAt,
+		DeletedAt:       order.DeletedAt,
+		ArchivedAt:      now,
+		Snapshot:        snapshot,
+	}, nil
+}
+
+func (a *ArchivedOrder) DecodeSnapshot() (*ArchivedOrderSnapshot, error) {
+	var snapshot ArchivedOrderSnapshot
+	if err := json.Unmarshal(a.Snapshot, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// CanBeViewedBy hides orders that were deleted before archival from their
+// owner, as they were while active.
+func (a *ArchivedOrder) CanBeViewedBy(userID string, userRole UserRole) bool {
+	return userRole == AdminRole || (a.ClientID == userID && a.DeletedAt == nil)
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+var ErrVersionConflict = errors.New("order was modified by another request")
+
+// OrderEdit holds the fields a pending order accepts changes to. Nil fields
+// are left untouched. An address can only change together with its
+// coordinates, since those are what the order is priced and promised on.
+type OrderEdit struct {
+	OriginAddress      *Address
+	OriginCoords       *Coordinates
+	DestinationAddress *Address
+	DestinationCoords  *Coordinates
+	SenderContact      *Contact
+	RecipientContact   *Contact
+	TotalWeight        *float64
+	Dimensions         *Dimensions
+}
+
+func (e OrderEdit) changesWeight() bool {
+	return e.TotalWeight != nil || e.Dimensions != nil
+}
+
+// ChangedFields lists the request fields the edit sets, for the order history.
+func (e OrderEdit) ChangedFields() []string {
+	var fields []string
+	if e.OriginAddress != nil {
+		fields = append(fields, "origin_address")
+	}
+	if e.DestinationAddress != nil {
+		fields = append(fields, "destination_address")
+	}
+	if e.SenderContact != nil {
+		fields = append(fields, "sender_contact")
+	}
+	if e.RecipientContact != nil {
+		fields = append(fields, "recipient_contact")
+	}
+	if e.TotalWeight != nil {
+		fields = append(fields, "total_weight")
+	}
+	if e.Dimensions != nil {
+		fields = append(fields, "dimensions")
+	}
+	return fields
+}
+
+func (e OrderEdit) validate() error {
+	if (e.OriginAddress == nil) != (e.OriginCoords == nil) {
+		return errors.New("origin_address and origin_coordinates must be changed together")
+	}
+	if (e.DestinationAddress == nil) != (e.DestinationCoords == nil) {
+		return errors.New("destination_address and destination_coordinates must be changed together")
+	}
+	if len(e.ChangedFields()) == 0 {
+		return errors.New("no changes provided")
+	}
+	return nil
+}
+
+// ApplyEdit changes a pending order in place. It reports whether the route or
+// the billable weight changed so the caller can reprice the order and
+// recompute its delivery promise.
+func (o *Order) ApplyEdit(edit OrderEdit, volumetricDivisor float64) (bool, error) {
+	if o.Status != StatusCreated {
+		return false, errors.New("order can only be edited while in status " + string(StatusCreated))
+	}
+
+	if err := edit.validate(); err != nil {
+		return false, err
+	}
+
+	rerouted := false
+	if edit.OriginAddress != nil {
+		rerouted = rerouted || o.OriginCoords != *edit.OriginCoords
+		o.OriginAddress = *edit.OriginAddress
+		o.OriginCoords = *edit.OriginCoords
+	}
+
+	if edit.DestinationAddress != nil {
+		rerouted = rerouted || o.DestinationCoords != *edit.DestinationCoords
+		o.DestinationAddress = *edit.DestinationAddress
+		o.DestinationCoords = *edit.DestinationCoords
+	}
+
+	if err := o.SetContacts(edit.SenderContact, edit.RecipientContact); err != nil {
+		return false, err
+	}
+
+	if !edit.changesWeight() {
+		o.UpdatedAt = time.Now()
+		return rerouted, nil
+	}
+
+	if len(o.Parcels) > 1 {
+		return false, errors.New("weight and dimensions of multi-parcel orders can't be edited")
+	}
+
+	spec := ParcelSpec{Weight: o.TotalWeight, Dimensions: o.Dimensions}
+	if edit.TotalWeight != nil {
+		spec.Weight = *edit.TotalWeight
+	}
+	if edit.Dimensions != nil {
+		spec.Dimensions = edit.Dimensions
+	}
+
+	reweighed, err := NewParcel(o.ID, 1, spec, volumetricDivisor, o.Status)
+	if err != nil {
+		return false, err
+	}
+
+	packageSize := determinePackageSize(reweighed.BillableWeight)
+	if packageSize == PackageSizeSpecial {
+		return false, errors.New("edited weight exceeds the standard sizes; create a new order for special packages")
+	}
+
+	if len(o.Parcels) == 1 {
+		parcel := &o.Parcels[0]
+		parcel.Weight = reweighed.Weight
+		parcel.Dimensions = reweighed.Dimensions
+		parcel.VolumetricWeight = reweighed.VolumetricWeight
+		parcel.BillableWeight = reweighed.BillableWeight
+		parcel.UpdatedAt = time.Now()
+	}
+
+	previousBillable := o.BillableWeight
+	o.TotalWeight = reweighed.Weight
+	o.Dimensions = reweighed.Dimensions
+	o.VolumetricWeight = reweighed.VolumetricWeight
+	o.BillableWeight = reweighed.BillableWeight
+	o.PackageSize = packageSize
+	o.UpdatedAt = time.Now()
+
+	return rerouted || math.Abs(previousBillable-o.BillableWeight) > 0.001, nil
+}
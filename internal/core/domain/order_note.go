@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NoteVisibility string
+
+const (
+	NoteVisibilityInternal NoteVisibility = "internal"
+	NoteVisibilityClient   NoteVisibility = "client"
+)
+
+var ErrInternalNoteNotPermitted = errors.New("only admins can write internal notes")
+
+// OrderNote is a free-text comment on an order. Internal notes are only shown
+// to admins; client-visible ones also to the order's owner.
+type OrderNote struct {
+	ID         string         `json:"id" gorm:"primaryKey"`
+	OrderID    string         `json:"order_id" gorm:"not null;index"`
+	AuthorID   string         `json:"author_id" gorm:"not null"`
+	AuthorRole UserRole       `json:"author_role" gorm:"not null"`
+	Visibility NoteVisibility `json:"visibility" gorm:"not null"`
+	Body       string         `json:"body" gorm:"type:text;not null"`
+	CreatedAt  time.Time      `json:"created_at" gorm:"not null;index"`
+}
+
+// NewOrderNote defaults the visibility to internal for admins and to
+// client-visible for clients, who can't write internal notes.
+func NewOrderNote(orderID, authorID string, authorRole UserRole, visibility NoteVisibility, body string) (*OrderNote, error) {
+	if orderID == "" {
+		return nil, errors.New("order id is required")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is required")
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, errors.New("note body is required")
+	}
+
+	if visibility == "" {
+		visibility = NoteVisibilityClient
+		if authorRole == AdminRole {
+			visibility = NoteVisibilityInternal
+		}
+	}
+
+	switch visibility {
+	case NoteVisibilityClient:
+	case NoteVisibilityInternal:
+		if authorRole != AdminRole {
+			return nil, ErrInternalNoteNotPermitted
+		}
+	default:
+		return nil, errors.New("invalid note visibility")
+	}
+
+	return &OrderNote{
+		ID:         uuid.New().String(),
+		OrderID:    orderID,
+		AuthorID:   authorID,
+		AuthorRole: authorRole,
+		Visibility: visibility,
+		Body:       body,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+func (n *OrderNote) CanBeViewedBy(userRole UserRole) bool {
+	return userRole == AdminRole || n.Visibility == NoteVisibilityClient
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderEventAction marks history entries that record something other than a
+// status change; the status stays the same on both sides.
+type OrderEventAction string
+
+const (
+	OrderEventDeleted  OrderEventAction = "deleted"
+	OrderEventRestored OrderEventAction = "restored"
+	OrderEventEdited   OrderEventAction = "edited"
+)
+
+type OrderStatusEvent struct {
+	ID         string           `json:"id" gorm:"primaryKey"`
+	OrderID    string           `json:"order_id" gorm:"not null;index"`
+	FromStatus OrderStatus      `json:"from_status"`
+	ToStatus   OrderStatus      `json:"to_status" gorm:"not null"`
+	Action     OrderEventAction `json:"action,omitempty"`
+	ActorID    string           `json:"actor_id" gorm:"not null"`
+	Reason     string           `json:"reason,omitempty"`
+	Location   string           `json:"location,omitempty"`
+	CreatedAt  time.Time        `json:"created_at" gorm:"not null;index"`
+}
+
+func NewOrderStatusEvent(orderID string, fromStatus, toStatus OrderStatus, actorID, reason, location string) (*OrderStatusEvent, error) {
+	if orderID == "" {
+		return nil, errors.New("order id is required")
+	}
+
+	if toStatus == "" {
+		return nil, errors.New("target status is required")
+	}
+
+	if actorID == "" {
+		return nil, errors.New("actor id is required")
+	}
+
+	return &OrderStatusEvent{
+		ID:         uuid.New().String(),
+		OrderID:    orderID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ActorID:    actorID,
+		Reason:     reason,
+		Location:   location,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// NewOrderActionEvent records a deletion, restore or edit in the order history.
+func NewOrderActionEvent(order *Order, action OrderEventAction, actorID, reason string) (*OrderStatusEvent, error) {
+	event, err := NewOrderStatusEvent(order.ID, order.Status, order.Status, actorID, reason, "")
+	if err != nil {
+		return nil, err
+	}
+	event.Action = action
+	return event, nil
+}
+
+// CanBeViewedBy hides deletions and restores, which carry internal reasons,
+// from clients.
+func (e *OrderStatusEvent) CanBeViewedBy(userRole UserRole) bool {
+	return userRole == AdminRole || e.Action == "" || e.Action == OrderEventEdited
+}
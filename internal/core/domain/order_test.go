@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func standardWorkflow(t *testing.T) *Workflow {
+	t.Helper()
+	for _, wf := range DefaultWorkflows() {
+		if wf.ServiceType == ServiceTypeStandard {
+			return wf
+		}
+	}
+	t.Fatal("no standard workflow")
+	return nil
+}
+
+func TestOrderCancelByClient(t *testing.T) {
+	now := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+	cutoff := time.Hour
+
+	tests := []struct {
+		name     string
+		status   OrderStatus
+		pickupAt *time.Time
+		wantErr  bool
+	}{
+		{name: "created", status: StatusCreated},
+		{name: "pending quote", status: StatusPendingQuote},
+		{name: "quoted", status: StatusQuoted},
+		{name: "collected", status: StatusCollected, wantErr: true},
+		{name: "in route", status: StatusInRoute, wantErr: true},
+		{name: "already cancelled", status: StatusCancelled, wantErr: true},
+		{name: "before the cutoff", status: StatusCreated, pickupAt: timePtr(now.Add(61 * time.Minute))},
+		{name: "at the cutoff", status: StatusCreated, pickupAt: timePtr(now.Add(time.Hour)), wantErr: true},
+		{name: "after pickup started", status: StatusCreated, pickupAt: timePtr(now.Add(-time.Minute)), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &Order{Status: tt.status, ServiceType: ServiceTypeStandard}
+			if tt.pickupAt != nil {
+				order.PickupWindow = &TimeWindow{Start: *tt.pickupAt, End: tt.pickupAt.Add(2 * time.Hour), TimeZone: "UTC"}
+			}
+
+			err := order.CancelByClient(standardWorkflow(t), now, cutoff)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CancelByClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && order.Status != StatusCancelled {
+				t.Errorf("status = %s, want %s", order.Status, StatusCancelled)
+			}
+			if err == nil && order.ClosedAt == nil {
+				t.Error("ClosedAt was not set when the order was cancelled")
+			}
+			if err != nil && order.Status != tt.status {
+				t.Errorf("status changed to %s on a rejected cancellation", order.Status)
+			}
+		})
+	}
+}
+
+func TestOrderCancelByClientFollowsWorkflow(t *testing.T) {
+	// A workflow that doesn't let clients withdraw quote requests.
+	workflow := &Workflow{
+		Name:        "strict",
+		ServiceType: ServiceTypeStandard,
+		States:      []OrderStatus{StatusCreated, StatusPendingQuote, StatusCancelled},
+		Transitions: []WorkflowTransition{
+			{From: StatusCreated, To: StatusCancelled, Roles: []UserRole{ClientRole}},
+			{From: StatusPendingQuote, To: StatusCancelled, Roles: []UserRole{AdminRole}},
+		},
+	}
+
+	order := &Order{Status: StatusPendingQuote, ServiceType: ServiceTypeStandard}
+	if err := order.CancelByClient(workflow, time.Now(), time.Hour); err == nil {
+		t.Fatal("CancelByClient() error = nil, want an error")
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestOrderApplyEdit(t *testing.T) {
+	address := Address{Street: "Reforma", ZipCode: "06600", ExtNum: "222", City: "CDMX", State: "CDMX", Country: "MX"}
+	origin := Coordinates{Latitude: 19.4326, Longitude: -99.1332}
+	destination := Coordinates{Latitude: 19.3600, Longitude: -99.1500}
+	moved := Coordinates{Latitude: 20.6597, Longitude: -103.3496}
+	heavier := 4.0
+
+	tests := []struct {
+		name        string
+		edit        OrderEdit
+		wantReprice bool
+		wantErr     bool
+	}{
+		{name: "contact only", edit: OrderEdit{SenderContact: &Contact{Name: "Ana", Phone: "+5215512345678"}}},
+		{name: "address with the same coordinates", edit: OrderEdit{DestinationAddress: &address, DestinationCoords: &destination}},
+		{name: "address with new coordinates", edit: OrderEdit{DestinationAddress: &address, DestinationCoords: &moved}, wantReprice: true},
+		{name: "weight change", edit: OrderEdit{TotalWeight: &heavier}, wantReprice: true},
+		{name: "address without coordinates", edit: OrderEdit{OriginAddress: &address}, wantErr: true},
+		{name: "coordinates without address", edit: OrderEdit{OriginCoords: &moved}, wantErr: true},
+		{name: "no changes", edit: OrderEdit{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, err := NewOrder("client-1", origin, destination, address, address, 1,
+				[]ParcelSpec{{Weight: 1}}, 5000, ServiceTypeStandard)
+			if err != nil {
+				t.Fatalf("NewOrder() error = %v", err)
+			}
+
+			reprice, err := order.ApplyEdit(tt.edit, 5000)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyEdit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if reprice != tt.wantReprice {
+				t.Errorf("ApplyEdit() reprice = %v, want %v", reprice, tt.wantReprice)
+			}
+			if tt.edit.DestinationCoords != nil && !tt.wantErr && order.DestinationCoords != *tt.edit.DestinationCoords {
+				t.Errorf("DestinationCoords = %+v, want %+v", order.DestinationCoords, *tt.edit.DestinationCoords)
+			}
+		})
+	}
+}
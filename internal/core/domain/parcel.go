@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// parcelProgress ranks the statuses a single piece can be in while the order
+// moves through the network.
+var parcelProgress = map[OrderStatus]int{
+	StatusCreated:   0,
+	StatusCollected: 1,
+	StatusAtStation: 2,
+	StatusInRoute:   3,
+	StatusDelivered: 4,
+}
+
+type ParcelSpec struct {
+	Weight     float64
+	Dimensions *Dimensions
+}
+
+type Parcel struct {
+	ID               string      `json:"id" gorm:"primaryKey"`
+	OrderID          string      `json:"order_id" gorm:"not null;index"`
+	Sequence         int         `json:"sequence" gorm:"not null"`
+	TrackingCode     string      `json:"tracking_code" gorm:"uniqueIndex;not null"`
+	Weight           float64     `json:"weight" gorm:"not null"`
+	Dimensions       *Dimensions `json:"dimensions,omitempty" gorm:"embedded;embeddedPrefix:dim_"`
+	VolumetricWeight float64     `json:"volumetric_weight"`
+	BillableWeight   float64     `json:"billable_weight" gorm:"not null"`
+	Status           OrderStatus `json:"status" gorm:"not null"`
+	CreatedAt        time.Time   `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time   `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func NewParcel(orderID string, sequence int, spec ParcelSpec, volumetricDivisor float64, status OrderStatus) (*Parcel, error) {
+	if spec.Weight <= 0 {
+		return nil, fmt.Errorf("parcel %d: weight must be greater than 0", sequence)
+	}
+
+	var volumetricWeight float64
+	if spec.Dimensions != nil {
+		if err := spec.Dimensions.Validate(); err != nil {
+			return nil, fmt.Errorf("parcel %d: invalid dimensions: %w", sequence, err)
+		}
+		volumetricWeight = spec.Dimensions.VolumetricWeight(volumetricDivisor)
+	}
+
+	return &Parcel{
+		ID:               uuid.New().String(),
+		OrderID:          orderID,
+		Sequence:         sequence,
+		Weight:           spec.Weight,
+		Dimensions:       spec.Dimensions,
+		VolumetricWeight: volumetricWeight,
+		BillableWeight:   math.Max(spec.Weight, volumetricWeight),
+		Status:           status,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}, nil
+}
+
+func (p *Parcel) IsDelivered() bool {
+	return p.Status == StatusDelivered
+}
+
+// UpdateParcelStatus moves a single piece along the workflow and rolls the
+// result up into the order status.
+func (o *Order) UpdateParcelStatus(workflow *Workflow, parcelID string, newStatus OrderStatus, role UserRole) error {
+	if !o.acceptsParcelUpdates() {
+		return errors.New("parcels can't be updated while the order is in status " + string(o.Status))
+	}
+
+	if _, ok := parcelProgress[newStatus]; !ok {
+		return errors.New("invalid parcel status " + string(newStatus))
+	}
+
+	parcel := o.FindParcel(parcelID)
+	if parcel == nil {
+		return errors.New("parcel not found")
+	}
+
+	transition, exists := workflow.FindTransition(parcel.Status, newStatus)
+	if !exists {
+		return errors.New("invalid parcel transition from " + string(parcel.Status) + " to " + string(newStatus))
+	}
+
+	if !transition.AllowsRole(role) {
+		return ErrTransitionNotPermitted
+	}
+
+	previousParcelStatus := parcel.Status
+	previousStatus := o.Status
+	parcel.Status = newStatus
+	rolled := o.rolledUpStatus()
+
+	// The order moves with its parcels only along a transition of its
+	// workflow whose guards hold, so the last parcel can't close the order
+	// without a proof and a workflow can forbid partial deliveries.
+	if rolled != previousStatus {
+		orderTransition, ok := workflow.FindTransition(previousStatus, rolled)
+		if !ok {
+			parcel.Status = previousParcelStatus
+			return errors.New("parcel update would move the order from " + string(previousStatus) + " to " + string(rolled) + ", which its workflow does not allow")
+		}
+		if !orderTransition.AllowsRole(role) {
+			parcel.Status = previousParcelStatus
+			return ErrTransitionNotPermitted
+		}
+		if err := o.checkGuards(orderTransition); err != nil {
+			parcel.Status = previousParcelStatus
+			return err
+		}
+	}
+
+	parcel.UpdatedAt = time.Now()
+	o.rollUpStatus()
+	return nil
+}
+
+func (o *Order) FindParcel(parcelID string) *Parcel {
+	for i := range o.Parcels {
+		if o.Parcels[i].ID == parcelID {
+			return &o.Parcels[i]
+		}
+	}
+	return nil
+}
+
+func (o *Order) acceptsParcelUpdates() bool {
+	switch o.Status {
+	case StatusCreated, StatusCollected, StatusAtStation, StatusInRoute, StatusPartiallyDelivered:
+		return len(o.Parcels) > 0
+	default:
+		return false
+	}
+}
+
+// rollUpStatus sets the order status from its parcels.
+func (o *Order) rollUpStatus() {
+	if len(o.Parcels) == 0 {
+		return
+	}
+
+	if rolled := o.rolledUpStatus(); rolled != o.Status {
+		o.setStatus(rolled, time.Now())
+	}
+}
+
+// rolledUpStatus derives the order status from its parcels: delivered once
+// every piece is delivered, partially delivered when only some are, and
+// otherwise the least advanced piece.
+func (o *Order) rolledUpStatus() OrderStatus {
+	if len(o.Parcels) == 0 {
+		return o.Status
+	}
+
+	delivered := 0
+	rolled := StatusDelivered
+	for _, parcel := range o.Parcels {
+		if parcel.IsDelivered() {
+			delivered++
+			continue
+		}
+		if parcelProgress[parcel.Status] < parcelProgress[rolled] {
+			rolled = parcel.Status
+		}
+	}
+
+	if delivered > 0 && delivered < len(o.Parcels) {
+		rolled = StatusPartiallyDelivered
+	}
+
+	return rolled
+}
+
+// propagateStatusToParcels keeps pieces that are not yet delivered in step with
+// an order-level transition.
+func (o *Order) propagateStatusToParcels() {
+	if o.Status == StatusPartiallyDelivered {
+		return
+	}
+
+	for i := range o.Parcels {
+		if o.Parcels[i].IsDelivered() || o.Parcels[i].Status == o.Status {
+			continue
+		}
+		o.Parcels[i].Status = o.Status
+		o.Parcels[i].UpdatedAt = o.UpdatedAt
+	}
+}
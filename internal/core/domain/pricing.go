@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrManualPricingRequired = errors.New("special packages are priced manually by an administrator")
+	ErrNoRateTable           = errors.New("no rate table defined for service type")
+)
+
+// DistanceBand charges RatePerKm for the kilometres that fall inside the band.
+// The last band may leave UpToKm at 0 to cover any remaining distance.
+type DistanceBand struct {
+	UpToKm    float64 `json:"up_to_km"`
+	RatePerKm float64 `json:"rate_per_km"`
+}
+
+type RateTable struct {
+	ServiceType    ServiceType             `json:"service_type"`
+	Currency       string                  `json:"currency"`
+	BaseFee        float64                 `json:"base_fee"`
+	DistanceBands  []DistanceBand          `json:"distance_bands"`
+	SizeSurcharges map[PackageSize]float64 `json:"size_surcharges"`
+	MinimumCharge  float64                 `json:"minimum_charge"`
+}
+
+type PriceLineItem struct {
+	Code        string  `json:"code"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+type PriceQuote struct {
+	ServiceType ServiceType     `json:"service_type"`
+	PackageSize PackageSize     `json:"package_size"`
+	DistanceKm  float64         `json:"distance_km"`
+	Currency    string          `json:"currency"`
+	Items       []PriceLineItem `json:"items"`
+	Total       float64         `json:"total"`
+}
+
+func (rt *RateTable) Validate() error {
+	if !IsValidServiceType(rt.ServiceType) {
+		return fmt.Errorf("invalid service type %q", rt.ServiceType)
+	}
+
+	if len(rt.Currency) != 3 {
+		return fmt.Errorf("currency must be a 3-letter ISO code")
+	}
+
+	if rt.BaseFee < 0 || rt.MinimumCharge < 0 {
+		return errors.New("base fee and minimum charge must not be negative")
+	}
+
+	if len(rt.DistanceBands) == 0 {
+		return errors.New("rate table must define at least one distance band")
+	}
+
+	var previous float64
+	for i, band := range rt.DistanceBands {
+		if band.RatePerKm < 0 {
+			return fmt.Errorf("distance band %d has a negative rate", i+1)
+		}
+		last := i == len(rt.DistanceBands)-1
+		if band.UpToKm == 0 && !last {
+			return fmt.Errorf("only the last distance band may be open-ended")
+		}
+		if band.UpToKm != 0 && band.UpToKm <= previous {
+			return fmt.Errorf("distance bands must be in ascending order")
+		}
+		previous = band.UpToKm
+	}
+
+	for size, surcharge := range rt.SizeSurcharges {
+		if size == PackageSizeSpecial || !isValidPackageSize(size) {
+			return fmt.Errorf("invalid package size %q in surcharges", size)
+		}
+		if surcharge < 0 {
+			return fmt.Errorf("surcharge for %s must not be negative", size)
+		}
+	}
+
+	return nil
+}
+
+// Quote prices a shipment of the given size over distanceKm. The distance is
+// charged band by band, and the total is raised to the minimum charge when it
+// falls below it.
+func (rt *RateTable) Quote(distanceKm float64, size PackageSize) (*PriceQuote, error) {
+	if size == PackageSizeSpecial {
+		return nil, ErrManualPricingRequired
+	}
+
+	if !isValidPackageSize(size) {
+		return nil, fmt.Errorf("invalid package size %q", size)
+	}
+
+	if distanceKm < 0 {
+		return nil, errors.New("distance must not be negative")
+	}
+
+	items := []PriceLineItem{{Code: "base_fee", Description: "Base fee", Amount: RoundAmount(rt.BaseFee)}}
+
+	var lower float64
+	for _, band := range rt.DistanceBands {
+		if distanceKm <= lower {
+			break
+		}
+		upper := distanceKm
+		if band.UpToKm != 0 && band.UpToKm < distanceKm {
+			upper = band.UpToKm
+		}
+		km := upper - lower
+		description := fmt.Sprintf("Distance %.0f-%.0f km (%.2f km)", lower, upper, km)
+		if band.UpToKm == 0 {
+			description = fmt.Sprintf("Distance over %.0f km (%.2f km)", lower, km)
+		}
+		items = append(items, PriceLineItem{
+			Code:        "distance",
+			Description: description,
+			Amount:      RoundAmount(km * band.RatePerKm),
+		})
+		lower = upper
+	}
+
+	if surcharge := rt.SizeSurcharges[size]; surcharge > 0 {
+		items = append(items, PriceLineItem{
+			Code:        "size_surcharge",
+			Description: "Package size " + string(size),
+			Amount:      RoundAmount(surcharge),
+		})
+	}
+
+	var total float64
+	for _, item := range items {
+		total += item.Amount
+	}
+
+	if total < rt.MinimumCharge {
+		items = append(items, PriceLineItem{
+			Code:        "minimum_charge",
+			Description: "Adjustment to minimum charge",
+			Amount:      RoundAmount(rt.MinimumCharge - total),
+		})
+		total = rt.MinimumCharge
+	}
+
+	return &PriceQuote{
+		ServiceType: rt.ServiceType,
+		PackageSize: size,
+		DistanceKm:  math.Round(distanceKm*100) / 100,
+		Currency:    rt.Currency,
+		Items:       items,
+		Total:       RoundAmount(total),
+	}, nil
+}
+
+// DefaultRateTables returns the built-in rates used when no rates file is
+// configured for a service type.
+func DefaultRateTables() []*RateTable {
+	return []*RateTable{
+		{
+			ServiceType: ServiceTypeStandard,
+			Currency:    "USD",
+			BaseFee:     3.50,
+			DistanceBands: []DistanceBand{
+				{UpToKm: 10, RatePerKm: 0.60},
+				{UpToKm: 50, RatePerKm: 0.40},
+				{UpToKm: 0, RatePerKm: 0.25},
+			},
+			SizeSurcharges: map[PackageSize]float64{
+				PackageSizeM: 2.00,
+				PackageSizeL: 5.00,
+			},
+			MinimumCharge: 5.00,
+		},
+		{
+			ServiceType: ServiceTypeExpress,
+			Currency:    "USD",
+			BaseFee:     6.00,
+			DistanceBands: []DistanceBand{
+				{UpToKm: 10, RatePerKm: 0.90},
+				{UpToKm: 50, RatePerKm: 0.60},
+				{UpToKm: 0, RatePerKm: 0.40},
+			},
+			SizeSurcharges: map[PackageSize]float64{
+				PackageSizeM: 3.00,
+				PackageSizeL: 7.50,
+			},
+			MinimumCharge: 9.00,
+		},
+	}
+}
+
+func isValidPackageSize(size PackageSize) bool {
+	for _, valid := range GetValidPackageSizes() {
+		if valid == size {
+			return true
+		}
+	}
+	return false
+}
+
+// RoundAmount rounds a money amount to cents.
+func RoundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
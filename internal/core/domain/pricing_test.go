@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func standardRateTable(t *testing.T) *RateTable {
+	t.Helper()
+	for _, table := range DefaultRateTables() {
+		if table.ServiceType == ServiceTypeStandard {
+			return table
+		}
+	}
+	t.Fatal("no standard rate table")
+	return nil
+}
+
+func TestRateTableQuote(t *testing.T) {
+	tests := []struct {
+		name          string
+		distanceKm    float64
+		size          PackageSize
+		wantTotal     float64
+		wantDistances int
+		wantMinimum   bool
+	}{
+		{name: "zero distance raised to minimum", distanceKm: 0, size: PackageSizeS, wantTotal: 5.00, wantMinimum: true},
+		{name: "short trip raised to minimum", distanceKm: 2, size: PackageSizeS, wantTotal: 5.00, wantDistances: 1, wantMinimum: true},
+		{name: "end of first band", distanceKm: 10, size: PackageSizeS, wantTotal: 9.50, wantDistances: 1},
+		{name: "just past first band", distanceKm: 11, size: PackageSizeS, wantTotal: 9.90, wantDistances: 2},
+		{name: "end of second band", distanceKm: 50, size: PackageSizeM, wantTotal: 27.50, wantDistances: 2},
+		{name: "open-ended band", distanceKm: 60, size: PackageSizeL, wantTotal: 33.00, wantDistances: 3},
+		{name: "fractional distance", distanceKm: 10.333, size: PackageSizeS, wantTotal: 9.63, wantDistances: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote, err := standardRateTable(t).Quote(tt.distanceKm, tt.size)
+			if err != nil {
+				t.Fatalf("Quote() error = %v", err)
+			}
+
+			if quote.Total != tt.wantTotal {
+				t.Errorf("Total = %v, want %v", quote.Total, tt.wantTotal)
+			}
+
+			var distances int
+			var minimum, sum float64
+			for _, item := range quote.Items {
+				sum += item.Amount
+				switch item.Code {
+				case "distance":
+					distances++
+				case "minimum_charge":
+					minimum = item.Amount
+				}
+			}
+
+			if distances != tt.wantDistances {
+				t.Errorf("distance items = %d, want %d", distances, tt.wantDistances)
+			}
+			if (minimum > 0) != tt.wantMinimum {
+				t.Errorf("minimum charge adjustment = %v, want one: %v", minimum, tt.wantMinimum)
+			}
+			if RoundAmount(sum) != quote.Total {
+				t.Errorf("items add up to %v, total is %v", RoundAmount(sum), quote.Total)
+			}
+		})
+	}
+}
+
+func TestRateTableQuoteErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		size       PackageSize
+		wantErr    error
+	}{
+		{name: "special package", distanceKm: 10, size: PackageSizeSpecial, wantErr: ErrManualPricingRequired},
+		{name: "unknown size", distanceKm: 10, size: PackageSize("XL")},
+		{name: "negative distance", distanceKm: -1, size: PackageSizeS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := standardRateTable(t).Quote(tt.distanceKm, tt.size)
+			if err == nil {
+				t.Fatal("Quote() error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Quote() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxProofFileSize caps signature and photo uploads.
+const MaxProofFileSize = 5 << 20
+
+type DeliveryRelationship string
+
+const (
+	RelationshipRecipient DeliveryRelationship = "recipient"
+	RelationshipFamily    DeliveryRelationship = "family"
+	RelationshipNeighbor  DeliveryRelationship = "neighbor"
+	RelationshipReception DeliveryRelationship = "reception"
+	RelationshipOther     DeliveryRelationship = "other"
+)
+
+type ProofFileKind string
+
+const (
+	ProofFileSignature ProofFileKind = "signature"
+	ProofFilePhoto     ProofFileKind = "photo"
+)
+
+// allowedProofContentTypes maps the accepted upload types to the extension
+// they are stored with.
+var allowedProofContentTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+}
+
+type ProofOfDelivery struct {
+	ID            string               `json:"id" gorm:"primaryKey"`
+	OrderID       string               `json:"order_id" gorm:"not null;uniqueIndex"`
+	RecipientName string               `json:"recipient_name" gorm:"not null"`
+	Relationship  DeliveryRelationship `json:"relationship" gorm:"not null"`
+	Location      Coordinates          `json:"location" gorm:"embedded;embeddedPrefix:location_"`
+	SignatureKey  string               `json:"-"`
+	PhotoKey      string               `json:"-"`
+	ActorID       string               `json:"actor_id" gorm:"not null"`
+	DeliveredAt   time.Time            `json:"delivered_at" gorm:"not null"`
+}
+
+func NewProofOfDelivery(orderID, recipientName string, relationship DeliveryRelationship, location Coordinates, actorID string) (*ProofOfDelivery, error) {
+	if orderID == "" {
+		return nil, errors.New("order id is required")
+	}
+
+	recipientName = strings.TrimSpace(recipientName)
+	if recipientName == "" {
+		return nil, errors.New("recipient name is required")
+	}
+
+	if !IsValidDeliveryRelationship(relationship) {
+		return nil, errors.New("invalid recipient relationship")
+	}
+
+	if err := validateCoordinates(location); err != nil {
+		return nil, errors.New("invalid delivery location: " + err.Error())
+	}
+
+	if actorID == "" {
+		return nil, errors.New("actor id is required")
+	}
+
+	return &ProofOfDelivery{
+		ID:            uuid.New().String(),
+		OrderID:       orderID,
+		RecipientName: recipientName,
+		Relationship:  relationship,
+		Location:      location,
+		ActorID:       actorID,
+		DeliveredAt:   time.Now(),
+	}, nil
+}
+
+// FileKey returns the storage key for a proof file of the given content type.
+func (p *ProofOfDelivery) FileKey(kind ProofFileKind, contentType string) (string, error) {
+	ext, ok := allowedProofContentTypes[contentType]
+	if !ok {
+		return "", errors.New(string(kind) + " must be a PNG or JPEG image")
+	}
+	return "proof-of-delivery/" + p.OrderID + "/" + string(kind) + ext, nil
+}
+
+func (p *ProofOfDelivery) KeyFor(kind ProofFileKind) string {
+	switch kind {
+	case ProofFileSignature:
+		return p.SignatureKey
+	case ProofFilePhoto:
+		return p.PhotoKey
+	default:
+		return ""
+	}
+}
+
+// Deliver closes the order with the proof collected at the door.
+func (o *Order) Deliver(workflow *Workflow, role UserRole, proof *ProofOfDelivery) error {
+	if proof == nil {
+		return errors.New("proof of delivery is required")
+	}
+
+	if o.Status != StatusInRoute && o.Status != StatusPartiallyDelivered {
+		return errors.New("order can only be delivered while in status " + string(StatusInRoute) + " or " + string(StatusPartiallyDelivered))
+	}
+
+	previous := o.ProofOfDelivery
+	o.ProofOfDelivery = proof
+	if err := o.TransitionTo(workflow, StatusDelivered, role); err != nil {
+		o.ProofOfDelivery = previous
+		return err
+	}
+	return nil
+}
+
+func IsValidDeliveryRelationship(relationship DeliveryRelationship) bool {
+	for _, valid := range GetValidDeliveryRelationships() {
+		if valid == relationship {
+			return true
+		}
+	}
+	return false
+}
+
+func GetValidDeliveryRelationships() []DeliveryRelationship {
+	return []DeliveryRelationship{
+		RelationshipRecipient, RelationshipFamily, RelationshipNeighbor,
+		RelationshipReception, RelationshipOther,
+	}
+}
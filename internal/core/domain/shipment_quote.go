@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrQuoteExpired  = errors.New("quote has expired; request a new one")
+	ErrQuoteMismatch = errors.New("order does not match the quoted route, package size or service type")
+)
+
+// ShipmentQuote is a price given by POST /quotes, kept so an order created
+// before ExpiresAt pays what was quoted even if the rates change. The insurance
+// premium is not part of it; it is added when the order is priced.
+type ShipmentQuote struct {
+	ID                string          `json:"id" gorm:"primaryKey"`
+	ClientID          string          `json:"client_id" gorm:"not null;index"`
+	OriginCoords      Coordinates     `json:"origin_coordinates" gorm:"embedded;embeddedPrefix:origin_"`
+	DestinationCoords Coordinates     `json:"destination_coordinates" gorm:"embedded;embeddedPrefix:destination_"`
+	PackageSize       PackageSize     `json:"package_size" gorm:"not null"`
+	ServiceType       ServiceType     `json:"service_type" gorm:"not null"`
+	DistanceKm        float64         `json:"distance_km"`
+	Currency          string          `json:"currency" gorm:"size:3;not null"`
+	Items             []PriceLineItem `json:"items" gorm:"type:jsonb;serializer:json"`
+	Total             float64         `json:"total" gorm:"not null"`
+	ExpiresAt         time.Time       `json:"expires_at" gorm:"not null;index"`
+	CreatedAt         time.Time       `json:"created_at" gorm:"not null"`
+}
+
+func NewShipmentQuote(clientID string, origin, destination Coordinates, quote *PriceQuote, now time.Time, ttl time.Duration) *ShipmentQuote {
+	items := make([]PriceLineItem, len(quote.Items))
+	copy(items, quote.Items)
+
+	return &ShipmentQuote{
+		ID:                uuid.New().String(),
+		ClientID:          clientID,
+		OriginCoords:      origin,
+		DestinationCoords: destination,
+		PackageSize:       quote.PackageSize,
+		ServiceType:       quote.ServiceType,
+		DistanceKm:        quote.DistanceKm,
+		Currency:          quote.Currency,
+		Items:             items,
+		Total:             quote.Total,
+		ExpiresAt:         now.Add(ttl),
+		CreatedAt:         now,
+	}
+}
+
+// PriceFor returns the quoted price for the order, checking that the quote is
+// still valid and was given for the same route, size and service.
+func (q *ShipmentQuote) PriceFor(order *Order, now time.Time) (*PriceQuote, error) {
+	if !now.Before(q.ExpiresAt) {
+		return nil, ErrQuoteExpired
+	}
+
+	if q.OriginCoords != order.OriginCoords || q.DestinationCoords != order.DestinationCoords ||
+		q.PackageSize != order.PackageSize || q.ServiceType != order.ServiceType {
+		return nil, ErrQuoteMismatch
+	}
+
+	items := make([]PriceLineItem, len(q.Items))
+	copy(items, q.Items)
+
+	return &PriceQuote{
+		ServiceType: q.ServiceType,
+		PackageSize: q.PackageSize,
+		DistanceKm:  q.DistanceKm,
+		Currency:    q.Currency,
+		Items:       items,
+		Total:       q.Total,
+	}, nil
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const holidayLayout = "2006-01-02"
+
+// SLABand promises delivery within BusinessDays for shipments up to UpToKm.
+// The last band may leave UpToKm at 0 to cover any longer distance.
+type SLABand struct {
+	UpToKm       float64 `json:"up_to_km"`
+	BusinessDays int     `json:"business_days"`
+}
+
+type SLAPolicy struct {
+	ServiceType ServiceType `json:"service_type"`
+	Bands       []SLABand   `json:"bands"`
+}
+
+// BusinessCalendar knows which days count towards a delivery promise.
+// Orders placed after CutoffHour start counting on the next business day, and
+// promises fall due at DeliveryHour local time.
+type BusinessCalendar struct {
+	location     *time.Location
+	holidays     map[string]bool
+	cutoffHour   int
+	deliveryHour int
+}
+
+func NewBusinessCalendar(timeZone string, holidays []string, cutoffHour, deliveryHour int) (*BusinessCalendar, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar time zone %q", timeZone)
+	}
+
+	if cutoffHour < 0 || cutoffHour > 23 || deliveryHour < 0 || deliveryHour > 23 {
+		return nil, errors.New("cutoff and delivery hours must be between 0 and 23")
+	}
+
+	days := make(map[string]bool, len(holidays))
+	for _, holiday := range holidays {
+		day, err := time.Parse(holidayLayout, holiday)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday %q, expected YYYY-MM-DD", holiday)
+		}
+		days[day.Format(holidayLayout)] = true
+	}
+
+	return &BusinessCalendar{
+		location:     location,
+		holidays:     days,
+		cutoffHour:   cutoffHour,
+		deliveryHour: deliveryHour,
+	}, nil
+}
+
+func (c *BusinessCalendar) IsBusinessDay(day time.Time) bool {
+	day = day.In(c.location)
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return false
+	}
+	return !c.holidays[day.Format(holidayLayout)]
+}
+
+// AddBusinessDays returns the due time businessDays working days after from.
+func (c *BusinessCalendar) AddBusinessDays(from time.Time, businessDays int) time.Time {
+	local := from.In(c.location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location)
+
+	// The clock starts on the first business day that still accepts orders
+	if local.Hour() >= c.cutoffHour || !c.IsBusinessDay(day) {
+		day = c.nextBusinessDay(day)
+	}
+
+	for i := 0; i < businessDays; i++ {
+		day = c.nextBusinessDay(day)
+	}
+
+	return time.Date(day.Year(), day.Month(), day.Day(), c.deliveryHour, 0, 0, 0, c.location).UTC()
+}
+
+func (c *BusinessCalendar) nextBusinessDay(day time.Time) time.Time {
+	next := day.AddDate(0, 0, 1)
+	for !c.IsBusinessDay(next) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+func (p *SLAPolicy) Validate() error {
+	if !IsValidServiceType(p.ServiceType) {
+		return fmt.Errorf("invalid service type %q", p.ServiceType)
+	}
+
+	if len(p.Bands) == 0 {
+		return errors.New("SLA policy must define at least one band")
+	}
+
+	var previous float64
+	for i, band := range p.Bands {
+		if band.BusinessDays < 0 {
+			return fmt.Errorf("SLA band %d has negative business days", i+1)
+		}
+		last := i == len(p.Bands)-1
+		if band.UpToKm == 0 && !last {
+			return errors.New("only the last SLA band may be open-ended")
+		}
+		if band.UpToKm != 0 && band.UpToKm <= previous {
+			return errors.New("SLA bands must be in ascending order")
+		}
+		previous = band.UpToKm
+	}
+
+	if p.Bands[len(p.Bands)-1].UpToKm != 0 {
+		return errors.New("the last SLA band must be open-ended")
+	}
+
+	return nil
+}
+
+// BusinessDaysFor returns the transit days promised for distanceKm.
+func (p *SLAPolicy) BusinessDaysFor(distanceKm float64) int {
+	for _, band := range p.Bands {
+		if band.UpToKm == 0 || distanceKm <= band.UpToKm {
+			return band.BusinessDays
+		}
+	}
+	return p.Bands[len(p.Bands)-1].BusinessDays
+}
+
+// DefaultSLAPolicies returns the built-in transit times per service type.
+func DefaultSLAPolicies() []*SLAPolicy {
+	return []*SLAPolicy{
+		{
+			ServiceType: ServiceTypeStandard,
+			Bands: []SLABand{
+				{UpToKm: 50, BusinessDays: 2},
+				{UpToKm: 300, BusinessDays: 3},
+				{UpToKm: 0, BusinessDays: 5},
+			},
+		},
+		{
+			ServiceType: ServiceTypeExpress,
+			Bands: []SLABand{
+				{UpToKm: 50, BusinessDays: 1},
+				{UpToKm: 300, BusinessDays: 2},
+				{UpToKm: 0, BusinessDays: 3},
+			},
+		},
+	}
+}
+
+// SLAStartAt is when the promise clock starts: creation or quote acceptance,
+// or the start of the pickup window when one is scheduled later.
+func (o *Order) SLAStartAt() time.Time {
+	start := o.CreatedAt
+	if o.QuoteAcceptedAt != nil && o.QuoteAcceptedAt.After(start) {
+		start = *o.QuoteAcceptedAt
+	}
+	if o.PickupWindow.IsSet() && o.PickupWindow.Start.After(start) {
+		start = o.PickupWindow.Start
+	}
+	return start
+}
+
+// IsOverdue reports whether the promised delivery date passed while the
+// order was still open.
+func (o *Order) IsOverdue(now time.Time) bool {
+	if o.PromisedDeliveryAt == nil {
+		return false
+	}
+	for _, status := range GetClosedStatuses() {
+		if o.Status == status {
+			return false
+		}
+	}
+	return now.After(*o.PromisedDeliveryAt)
+}
+
+// GetClosedStatuses lists the statuses an order no longer moves out of.
+func GetClosedStatuses() []OrderStatus {
+	return []OrderStatus{StatusDelivered, StatusCancelled, StatusReturned}
+}
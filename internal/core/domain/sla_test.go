@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessCalendarAddBusinessDays(t *testing.T) {
+	// 2026-10-20 is a Tuesday holiday; 2026-10-17 and 18 are a weekend.
+	calendar, err := NewBusinessCalendar("UTC", []string{"2026-10-20"}, 14, 20)
+	if err != nil {
+		t.Fatalf("NewBusinessCalendar() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		from time.Time
+		days int
+		want time.Time
+	}{
+		{
+			name: "before cutoff counts from the same day",
+			from: time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC),
+			days: 1,
+			want: time.Date(2026, 10, 13, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "after cutoff counts from the next business day",
+			from: time.Date(2026, 10, 12, 15, 0, 0, 0, time.UTC),
+			days: 1,
+			want: time.Date(2026, 10, 14, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "at cutoff counts from the next business day",
+			from: time.Date(2026, 10, 19, 14, 0, 0, 0, time.UTC),
+			days: 0,
+			want: time.Date(2026, 10, 21, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "zero days before cutoff is due the same day",
+			from: time.Date(2026, 10, 19, 13, 59, 0, 0, time.UTC),
+			days: 0,
+			want: time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "skips the weekend",
+			from: time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC),
+			days: 1,
+			want: time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "order on a weekend starts on monday",
+			from: time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC),
+			days: 1,
+			want: time.Date(2026, 10, 21, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "skips a holiday in the middle",
+			from: time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+			days: 2,
+			want: time.Date(2026, 10, 22, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "order on a holiday starts the next business day",
+			from: time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC),
+			days: 1,
+			want: time.Date(2026, 10, 22, 20, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendar.AddBusinessDays(tt.from, tt.days); !got.Equal(tt.want) {
+				t.Errorf("AddBusinessDays(%s, %d) = %s, want %s", tt.from, tt.days, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusinessCalendarUsesLocalTime(t *testing.T) {
+	calendar, err := NewBusinessCalendar("America/Mexico_City", nil, 14, 20)
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	// 19:30 UTC is 13:30 in Mexico City, still before the cutoff.
+	from := time.Date(2026, 10, 12, 19, 30, 0, 0, time.UTC)
+	want := time.Date(2026, 10, 14, 2, 0, 0, 0, time.UTC)
+	if got := calendar.AddBusinessDays(from, 1); !got.Equal(want) {
+		t.Errorf("AddBusinessDays() = %s, want %s", got, want)
+	}
+}
+
+func TestNewBusinessCalendarErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		timeZone     string
+		holidays     []string
+		cutoffHour   int
+		deliveryHour int
+	}{
+		{name: "unknown time zone", timeZone: "Mars/Olympus", cutoffHour: 14, deliveryHour: 20},
+		{name: "malformed holiday", timeZone: "UTC", holidays: []string{"25/12/2026"}, cutoffHour: 14, deliveryHour: 20},
+		{name: "invalid holiday date", timeZone: "UTC", holidays: []string{"2026-13-01"}, cutoffHour: 14, deliveryHour: 20},
+		{name: "cutoff out of range", timeZone: "UTC", cutoffHour: 24, deliveryHour: 20},
+		{name: "delivery hour out of range", timeZone: "UTC", cutoffHour: 14, deliveryHour: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewBusinessCalendar(tt.timeZone, tt.holidays, tt.cutoffHour, tt.deliveryHour); err == nil {
+				t.Error("NewBusinessCalendar() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestSLAPolicyBusinessDaysFor(t *testing.T) {
+	policy := &SLAPolicy{
+		ServiceType: ServiceTypeStandard,
+		Bands: []SLABand{
+			{UpToKm: 50, BusinessDays: 2},
+			{UpToKm: 300, BusinessDays: 3},
+			{UpToKm: 0, BusinessDays: 5},
+		},
+	}
+
+	tests := []struct {
+		distanceKm float64
+		want       int
+	}{
+		{distanceKm: 0, want: 2},
+		{distanceKm: 50, want: 2},
+		{distanceKm: 50.01, want: 3},
+		{distanceKm: 300, want: 3},
+		{distanceKm: 1200, want: 5},
+	}
+
+	for _, tt := range tests {
+		if got := policy.BusinessDaysFor(tt.distanceKm); got != tt.want {
+			t.Errorf("BusinessDaysFor(%v) = %d, want %d", tt.distanceKm, got, tt.want)
+		}
+	}
+}
+
+func TestSLAPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		bands   []SLABand
+		wantErr bool
+	}{
+		{name: "valid", bands: []SLABand{{UpToKm: 50, BusinessDays: 1}, {UpToKm: 0, BusinessDays: 2}}},
+		{name: "single open band", bands: []SLABand{{UpToKm: 0, BusinessDays: 2}}},
+		{name: "no bands", wantErr: true},
+		{name: "last band closed", bands: []SLABand{{UpToKm: 50, BusinessDays: 1}}, wantErr: true},
+		{name: "open band before the last", bands: []SLABand{{UpToKm: 0, BusinessDays: 1}, {UpToKm: 0, BusinessDays: 2}}, wantErr: true},
+		{name: "descending bands", bands: []SLABand{{UpToKm: 300, BusinessDays: 1}, {UpToKm: 50, BusinessDays: 2}, {UpToKm: 0, BusinessDays: 3}}, wantErr: true},
+		{name: "negative days", bands: []SLABand{{UpToKm: 0, BusinessDays: -1}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &SLAPolicy{ServiceType: ServiceTypeExpress, Bands: tt.bands}
+			if err := policy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
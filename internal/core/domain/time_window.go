@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// localTimeLayout is accepted for window bounds given without an offset; they
+// are read in the window's time zone.
+const localTimeLayout = "2006-01-02T15:04:05"
+
+// TimeWindow is a span of time in an explicit IANA time zone. Start and End
+// are stored in UTC.
+type TimeWindow struct {
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	TimeZone string    `json:"time_zone"`
+}
+
+// ParseTimeWindow builds a window from start and end given either in RFC3339
+// or as local times in timeZone.
+func ParseTimeWindow(start, end, timeZone string) (*TimeWindow, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "" || timeZone == "Local" {
+		return nil, fmt.Errorf("invalid time zone %q", timeZone)
+	}
+
+	startAt, err := parseWindowTime(start, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid window start: %w", err)
+	}
+
+	endAt, err := parseWindowTime(end, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid window end: %w", err)
+	}
+
+	if !endAt.After(startAt) {
+		return nil, errors.New("window end must be after its start")
+	}
+
+	return &TimeWindow{
+		Start:    startAt.UTC(),
+		End:      endAt.UTC(),
+		TimeZone: timeZone,
+	}, nil
+}
+
+func parseWindowTime(value string, location *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation(localTimeLayout, value, location)
+}
+
+// IsSet reports whether the window holds a value. Windows loaded from rows
+// without one come back zeroed rather than nil.
+func (w *TimeWindow) IsSet() bool {
+	return w != nil && !w.Start.IsZero()
+}
+
+// Location returns the window's time zone, falling back to UTC.
+func (w *TimeWindow) Location() *time.Location {
+	location, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// ScheduleWindows sets the pickup and delivery windows of a new order. Either
+// may be nil. Windows can't start before the order was created, and delivery
+// can't start before pickup does.
+func (o *Order) ScheduleWindows(pickup, delivery *TimeWindow) error {
+	earliest := o.CreatedAt.Truncate(time.Minute)
+
+	if pickup != nil && pickup.Start.Before(earliest) {
+		return errors.New("pickup window can't start before the order is created")
+	}
+
+	if delivery != nil && delivery.Start.Before(earliest) {
+		return errors.New("delivery window can't start before the order is created")
+	}
+
+	if pickup != nil && delivery != nil {
+		if delivery.Start.Before(pickup.Start) {
+			return errors.New("delivery window can't start before the pickup window")
+		}
+		if !delivery.End.After(pickup.Start) {
+			return errors.New("delivery window must end after pickup starts")
+		}
+	}
+
+	o.PickupWindow = pickup
+	o.DeliveryWindow = delivery
+	return nil
+}
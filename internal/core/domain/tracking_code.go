@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	trackingCodePrefix = "LG"
+	trackingCodeLength = 10
+	// trackingAlphabet leaves out 0, 1, I and O, which are easy to misread.
+	trackingAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	// parcelSequenceLength characters of the alphabet number the parcels of
+	// an order, up to 1023.
+	parcelSequenceLength = 2
+)
+
+var ErrTrackingCodeUnavailable = errors.New("failed to generate tracking code")
+
+// GenerateTrackingCode returns a public tracking code: a fixed prefix, random
+// characters and a trailing check character.
+func GenerateTrackingCode() (string, error) {
+	body := make([]byte, trackingCodeLength)
+	max := big.NewInt(int64(len(trackingAlphabet)))
+	for i := range body {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrTrackingCodeUnavailable, err)
+		}
+		body[i] = trackingAlphabet[n.Int64()]
+	}
+
+	return trackingCodePrefix + string(body) + string(trackingCheckChar(string(body))), nil
+}
+
+// ParcelTrackingCode derives a parcel's code from its order's: the order code,
+// the parcel sequence in the same alphabet and a check character over both.
+// Parcel codes are unique as long as order codes are.
+func ParcelTrackingCode(orderCode string, sequence int) (string, error) {
+	if !IsValidTrackingCode(orderCode) {
+		return "", fmt.Errorf("invalid order tracking code %q", orderCode)
+	}
+
+	n := len(trackingAlphabet)
+	if sequence < 1 || sequence >= n*n {
+		return "", fmt.Errorf("parcel sequence %d out of range", sequence)
+	}
+
+	suffix := string([]byte{trackingAlphabet[sequence/n], trackingAlphabet[sequence%n]})
+	body := orderCode[len(trackingCodePrefix):] + suffix
+	return orderCode + suffix + string(trackingCheckChar(body)), nil
+}
+
+// OrderCodeFromParcelCode returns the order code a normalized parcel code was
+// derived from, or false when the code isn't a valid parcel code.
+func OrderCodeFromParcelCode(code string) (string, bool) {
+	orderCodeLength := len(trackingCodePrefix) + trackingCodeLength + 1
+	if len(code) != orderCodeLength+parcelSequenceLength+1 {
+		return "", false
+	}
+
+	orderCode := code[:orderCodeLength]
+	if !IsValidTrackingCode(orderCode) {
+		return "", false
+	}
+
+	body := code[len(trackingCodePrefix) : len(code)-1]
+	for _, r := range body[len(body)-parcelSequenceLength:] {
+		if !strings.ContainsRune(trackingAlphabet, r) {
+			return "", false
+		}
+	}
+
+	if code[len(code)-1] != trackingCheckChar(body) {
+		return "", false
+	}
+	return orderCode, true
+}
+
+// NormalizeTrackingCode uppercases a code and drops the spaces and dashes
+// people add when typing it.
+func NormalizeTrackingCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// IsValidTrackingCode checks the prefix, alphabet and check character of a
+// normalized code.
+func IsValidTrackingCode(code string) bool {
+	if len(code) != len(trackingCodePrefix)+trackingCodeLength+1 || !strings.HasPrefix(code, trackingCodePrefix) {
+		return false
+	}
+
+	body := code[len(trackingCodePrefix) : len(code)-1]
+	for _, r := range body {
+		if !strings.ContainsRune(trackingAlphabet, r) {
+			return false
+		}
+	}
+
+	return code[len(code)-1] == trackingCheckChar(body)
+}
+
+// trackingCheckChar computes a Luhn mod N check character over the alphabet,
+// which catches single-character typos and most adjacent swaps.
+func trackingCheckChar(body string) byte {
+	n := len(trackingAlphabet)
+	factor := 2
+	sum := 0
+	for i := len(body) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(trackingAlphabet, body[i])
+		addend = addend/n + addend%n
+		sum += addend
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+	return trackingAlphabet[(n-sum%n)%n]
+}
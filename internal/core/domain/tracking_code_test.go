@@ -0,0 +1,125 @@
+package domain
+
+import "testing"
+
+func TestGenerateTrackingCode(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		code, err := GenerateTrackingCode()
+		if err != nil {
+			t.Fatalf("GenerateTrackingCode() error = %v", err)
+		}
+		if !IsValidTrackingCode(code) {
+			t.Fatalf("GenerateTrackingCode() = %q, which is not valid", code)
+		}
+	}
+}
+
+func TestIsValidTrackingCode(t *testing.T) {
+	body := "HXJALKDXZK"
+	valid := trackingCodePrefix + body + string(trackingCheckChar(body))
+
+	// A single mistyped character must change the check character.
+	typo := []byte(valid)
+	if typo[4] == 'A' {
+		typo[4] = 'B'
+	} else {
+		typo[4] = 'A'
+	}
+
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{name: "valid", code: valid, want: true},
+		{name: "normalized input", code: NormalizeTrackingCode(" lg-" + valid[2:7] + " " + valid[7:] + " "), want: true},
+		{name: "single character typo", code: string(typo), want: false},
+		{name: "wrong check character", code: valid[:len(valid)-1] + nextTrackingChar(valid[len(valid)-1]), want: false},
+		{name: "wrong prefix", code: "XX" + valid[2:], want: false},
+		{name: "too short", code: valid[:len(valid)-1], want: false},
+		{name: "too long", code: valid + "A", want: false},
+		{name: "ambiguous character", code: trackingCodePrefix + "0" + body[1:] + string(trackingCheckChar(body)), want: false},
+		{name: "lowercase without normalizing", code: "lg" + valid[2:], want: false},
+		{name: "empty", code: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidTrackingCode(tt.code); got != tt.want {
+				t.Errorf("IsValidTrackingCode(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func nextTrackingChar(c byte) string {
+	for i := 0; i < len(trackingAlphabet); i++ {
+		if trackingAlphabet[i] == c {
+			return string(trackingAlphabet[(i+1)%len(trackingAlphabet)])
+		}
+	}
+	return string(trackingAlphabet[0])
+}
+
+func TestParcelTrackingCode(t *testing.T) {
+	orderCode, err := GenerateTrackingCode()
+	if err != nil {
+		t.Fatalf("GenerateTrackingCode() error = %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for sequence := 1; sequence <= 50; sequence++ {
+		code, err := ParcelTrackingCode(orderCode, sequence)
+		if err != nil {
+			t.Fatalf("ParcelTrackingCode(%d) error = %v", sequence, err)
+		}
+		if seen[code] {
+			t.Fatalf("ParcelTrackingCode(%d) = %q, already used by another parcel", sequence, code)
+		}
+		seen[code] = true
+
+		got, ok := OrderCodeFromParcelCode(code)
+		if !ok || got != orderCode {
+			t.Fatalf("OrderCodeFromParcelCode(%q) = %q, %v, want %q", code, got, ok, orderCode)
+		}
+		if IsValidTrackingCode(code) {
+			t.Errorf("IsValidTrackingCode(%q) = true for a parcel code", code)
+		}
+	}
+
+	if _, err := ParcelTrackingCode(orderCode, 0); err == nil {
+		t.Error("ParcelTrackingCode(0) error = nil, want an error")
+	}
+	if _, err := ParcelTrackingCode("LG123", 1); err == nil {
+		t.Error("ParcelTrackingCode() with an invalid order code error = nil, want an error")
+	}
+}
+
+func TestOrderCodeFromParcelCode(t *testing.T) {
+	orderCode := trackingCodePrefix + "HXJALKDXZK" + string(trackingCheckChar("HXJALKDXZK"))
+	valid, err := ParcelTrackingCode(orderCode, 3)
+	if err != nil {
+		t.Fatalf("ParcelTrackingCode() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{name: "valid", code: valid, want: true},
+		{name: "order code", code: orderCode, want: false},
+		{name: "wrong check character", code: valid[:len(valid)-1] + nextTrackingChar(valid[len(valid)-1]), want: false},
+		{name: "sequence typo", code: valid[:len(valid)-2] + nextTrackingChar(valid[len(valid)-2]) + valid[len(valid)-1:], want: false},
+		{name: "legacy random code", code: "PCL3F9A0C12B4D5", want: false},
+		{name: "empty", code: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := OrderCodeFromParcelCode(tt.code); got != tt.want {
+				t.Errorf("OrderCodeFromParcelCode(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
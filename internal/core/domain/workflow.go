@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+type ServiceType string
+
+const (
+	ServiceTypeStandard ServiceType = "standard"
+	ServiceTypeExpress  ServiceType = "express"
+)
+
+var ErrTransitionNotPermitted = errors.New("role is not permitted to perform this status transition")
+
+// WorkflowGuard is a named condition an order must satisfy before a
+// transition that references it can run.
+type WorkflowGuard func(o *Order) error
+
+var workflowGuards = map[string]WorkflowGuard{
+	"standard_package_size": func(o *Order) error {
+		if o.PackageSize == PackageSizeSpecial && o.QuoteAcceptedAt == nil {
+			return errors.New("special packages require an accepted quote")
+		}
+		return nil
+	},
+	"proof_of_delivery": func(o *Order) error {
+		if o.ProofOfDelivery == nil {
+			return errors.New("proof of delivery must be captured")
+		}
+		return nil
+	},
+	"cash_on_delivery": func(o *Order) error {
+		if o.RequiresCashCollection() && !o.IsCashCollected() {
+			return errors.New("the cash on delivery amount must be confirmed")
+		}
+		return nil
+	},
+	"multiple_parcels": func(o *Order) error {
+		if len(o.Parcels) < 2 {
+			return errors.New("only orders with several parcels can be partially delivered")
+		}
+		return nil
+	},
+	"failed_delivery_attempt": func(o *Order) error {
+		if o.failedAttempt == nil {
+			return errors.New("a failed delivery attempt must be recorded")
+		}
+		return nil
+	},
+}
+
+type WorkflowTransition struct {
+	From   OrderStatus `json:"from"`
+	To     OrderStatus `json:"to"`
+	Roles  []UserRole  `json:"roles"`
+	Guards []string    `json:"guards,omitempty"`
+}
+
+type Workflow struct {
+	Name        string               `json:"name"`
+	ServiceType ServiceType          `json:"service_type"`
+	States      []OrderStatus        `json:"states"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+func (t WorkflowTransition) AllowsRole(role UserRole) bool {
+	for _, allowed := range t.Roles {
+		if allowed == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Workflow) Validate() error {
+	if w.Name == "" {
+		return errors.New("workflow name is required")
+	}
+
+	if !IsValidServiceType(w.ServiceType) {
+		return fmt.Errorf("invalid service type %q", w.ServiceType)
+	}
+
+	if len(w.States) == 0 {
+		return errors.New("workflow must define at least one state")
+	}
+
+	seen := make(map[OrderStatus]bool, len(w.States))
+	for _, state := range w.States {
+		if !IsValidStatus(state) {
+			return fmt.Errorf("unknown state %q", state)
+		}
+		if seen[state] {
+			return fmt.Errorf("duplicated state %q", state)
+		}
+		seen[state] = true
+	}
+
+	if !seen[StatusCreated] {
+		return fmt.Errorf("workflow must include the initial state %q", StatusCreated)
+	}
+
+	for _, t := range w.Transitions {
+		if !seen[t.From] || !seen[t.To] {
+			return fmt.Errorf("transition %s -> %s references a state outside the workflow", t.From, t.To)
+		}
+		if len(t.Roles) == 0 {
+			return fmt.Errorf("transition %s -> %s must allow at least one role", t.From, t.To)
+		}
+		for _, role := range t.Roles {
+			if role != AdminRole && role != ClientRole {
+				return fmt.Errorf("transition %s -> %s has invalid role %q", t.From, t.To, role)
+			}
+		}
+		for _, guard := range t.Guards {
+			if _, ok := workflowGuards[guard]; !ok {
+				return fmt.Errorf("transition %s -> %s has unknown guard %q", t.From, t.To, guard)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *Workflow) HasState(status OrderStatus) bool {
+	for _, state := range w.States {
+		if state == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Workflow) FindTransition(from, to OrderStatus) (WorkflowTransition, bool) {
+	for _, t := range w.Transitions {
+		if t.From == from && t.To == to {
+			return t, true
+		}
+	}
+	return WorkflowTransition{}, false
+}
+
+func (w *Workflow) AllowedTransitions(from OrderStatus) []OrderStatus {
+	var statuses []OrderStatus
+	for _, t := range w.Transitions {
+		if t.From == from {
+			statuses = append(statuses, t.To)
+		}
+	}
+	return statuses
+}
+
+// DefaultWorkflows returns the built-in definitions used when neither the
+// database nor the workflow config file provides one for a service type.
+func DefaultWorkflows() []*Workflow {
+	admin := []UserRole{AdminRole}
+	client := []UserRole{ClientRole}
+	adminOrClient := []UserRole{AdminRole, ClientRole}
+
+	standard := &Workflow{
+		Name:        "standard",
+		ServiceType: ServiceTypeStandard,
+		States: []OrderStatus{
+			StatusCreated, StatusCollected, StatusAtStation,
+			StatusInRoute, StatusDelivered, StatusCancelled,
+			StatusReturning, StatusReturned,
+			StatusPartiallyDelivered, StatusPendingQuote, StatusQuoted,
+		},
+		Transitions: []WorkflowTransition{
+			{From: StatusCreated, To: StatusCollected, Roles: admin, Guards: []string{"standard_package_size"}},
+			{From: StatusCreated, To: StatusCancelled, Roles: adminOrClient},
+			{From: StatusCollected, To: StatusAtStation, Roles: admin},
+			{From: StatusCollected, To: StatusCancelled, Roles: admin},
+			{From: StatusAtStation, To: StatusInRoute, Roles: admin},
+			{From: StatusAtStation, To: StatusCancelled, Roles: admin},
+			{From: StatusInRoute, To: StatusDelivered, Roles: admin, Guards: []string{"proof_of_delivery", "cash_on_delivery"}},
+			{From: StatusInRoute, To: StatusCancelled, Roles: admin},
+			{From: StatusInRoute, To: StatusAtStation, Roles: admin, Guards: []string{"failed_delivery_attempt"}},
+			{From: StatusInRoute, To: StatusReturning, Roles: admin, Guards: []string{"failed_delivery_attempt"}},
+			{From: StatusInRoute, To: StatusPartiallyDelivered, Roles: admin, Guards: []string{"multiple_parcels"}},
+			{From: StatusReturning, To: StatusReturned, Roles: admin},
+			{From: StatusPartiallyDelivered, To: StatusDelivered, Roles: admin, Guards: []string{"proof_of_delivery", "cash_on_delivery"}},
+			{From: StatusPendingQuote, To: StatusQuoted, Roles: admin},
+			{From: StatusPendingQuote, To: StatusCancelled, Roles: adminOrClient},
+			{From: StatusQuoted, To: StatusCreated, Roles: client},
+			{From: StatusQuoted, To: StatusCancelled, Roles: adminOrClient},
+		},
+	}
+
+	// Express skips the station hop: collected parcels go straight out for
+	// delivery.
+	express := standard.withTransitionTarget("express", ServiceTypeExpress, StatusCollected, StatusAtStation, StatusInRoute)
+
+	return []*Workflow{standard, express}
+}
+
+// withTransitionTarget copies the workflow under a new name and service type,
+// redirecting the from→oldTo transition to newTo.
+func (w *Workflow) withTransitionTarget(name string, serviceType ServiceType, from, oldTo, newTo OrderStatus) *Workflow {
+	copied := &Workflow{
+		Name:        name,
+		ServiceType: serviceType,
+		States:      append([]OrderStatus(nil), w.States...),
+		Transitions: make([]WorkflowTransition, len(w.Transitions)),
+	}
+	copy(copied.Transitions, w.Transitions)
+	for i, t := range copied.Transitions {
+		if t.From == from && t.To == oldTo {
+			copied.Transitions[i].To = newTo
+		}
+	}
+	return copied
+}
+
+func IsValidServiceType(serviceType ServiceType) bool {
+	for _, valid := range GetValidServiceTypes() {
+		if valid == serviceType {
+			return true
+		}
+	}
+	return false
+}
+
+func GetValidServiceTypes() []ServiceType {
+	return []ServiceType{ServiceTypeStandard, ServiceTypeExpress}
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+	"time"
+)
+
+// ArchivedOrderFilter narrows archive searches. Empty fields are ignored.
+type ArchivedOrderFilter struct {
+	ClientID        string
+	TrackingCode    string
+	Statuses        []domain.OrderStatus
+	CreatedFrom     *time.Time
+	CreatedTo       *time.Time
+	OriginCity      string
+	DestinationCity string
+
+	// Orders that were deleted before being archived are left out unless set.
+	IncludeDeleted bool
+}
+
+type ArchivedOrderRepository interface {
+	Create(ctx context.Context, order *domain.ArchivedOrder) error
+	GetByID(ctx context.Context, id string) (*domain.ArchivedOrder, error)
+	Search(ctx context.Context, filter ArchivedOrderFilter, limit, offset int) ([]*domain.ArchivedOrder, error)
+	Count(ctx context.Context, filter ArchivedOrderFilter) (int64, error)
+}
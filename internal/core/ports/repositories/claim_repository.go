@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+// ClaimFilter narrows the admin claim listing. Empty fields are ignored.
+type ClaimFilter struct {
+	Status   domain.ClaimStatus
+	ClientID string
+}
+
+// Create returns domain.ErrClaimAlreadyOpen when the order already has a
+// claim under review, and Update returns domain.ErrClaimAlreadyResolved when
+// the stored claim was resolved in the meantime.
+type ClaimRepository interface {
+	Create(ctx context.Context, claim *domain.Claim) error
+	GetByID(ctx context.Context, id string) (*domain.Claim, error)
+	Update(ctx context.Context, claim *domain.Claim) error
+	GetByOrderID(ctx context.Context, orderID string) ([]*domain.Claim, error)
+	List(ctx context.Context, filter ClaimFilter, limit, offset int) ([]*domain.Claim, error)
+	Count(ctx context.Context, filter ClaimFilter) (int64, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type DeliveryAttemptRepository interface {
+	Create(ctx context.Context, attempt *domain.DeliveryAttempt) error
+	GetByOrderID(ctx context.Context, orderID string) ([]*domain.DeliveryAttempt, error)
+}
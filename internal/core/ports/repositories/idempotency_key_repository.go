@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+	"time"
+)
+
+type IdempotencyKeyRepository interface {
+	// Create claims the key, returning domain.ErrIdempotencyKeyInUse when the
+	// client already holds it.
+	Create(ctx context.Context, key *domain.IdempotencyKey) error
+	Get(ctx context.Context, clientID, key string) (*domain.IdempotencyKey, error)
+	// Update and Delete only touch the key while it is still the in-progress
+	// claim made with it, returning domain.ErrIdempotencyKeyLost otherwise.
+	Update(ctx context.Context, key *domain.IdempotencyKey) error
+	Delete(ctx context.Context, key *domain.IdempotencyKey) error
+	// DeleteReclaimable deletes the key only if it is still expired or its
+	// lock timed out at now, reporting whether it did.
+	DeleteReclaimable(ctx context.Context, clientID, key string, now time.Time) (bool, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type ImportJobRepository interface {
+	Create(ctx context.Context, job *domain.ImportJob) error
+	GetByID(ctx context.Context, id string) (*domain.ImportJob, error)
+	Update(ctx context.Context, job *domain.ImportJob) error
+	// AbortUnfinished fails every pending or running job, returning how many
+	// were affected.
+	AbortUnfinished(ctx context.Context, reason string) (int64, error)
+}
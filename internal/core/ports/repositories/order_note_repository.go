@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type OrderNoteRepository interface {
+	Create(ctx context.Context, note *domain.OrderNote) error
+	GetByOrderID(ctx context.Context, orderID string) ([]*domain.OrderNote, error)
+}
@@ -3,18 +3,86 @@ package repositories
 import (
 	"context"
 	"logistics-api/internal/core/domain"
+	"time"
 )
 
+// OrderFilter narrows order listings. Empty fields are ignored; window bounds
+// match orders whose window overlaps the given range. Cities match
+// case-insensitively and zip codes by prefix.
+type OrderFilter struct {
+	ClientID     string
+	Statuses     []domain.OrderStatus
+	PackageSize  domain.PackageSize
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	PickupFrom   *time.Time
+	PickupTo     *time.Time
+	DeliveryFrom *time.Time
+	DeliveryTo   *time.Time
+
+	OriginCity           string
+	DestinationCity      string
+	OriginZipPrefix      string
+	DestinationZipPrefix string
+
+	PromisedBefore  *time.Time
+	ClosedBefore    *time.Time
+	ExcludeStatuses []domain.OrderStatus
+
+	// Soft-deleted orders are left out unless one of these is set.
+	IncludeDeleted bool
+	OnlyDeleted    bool
+}
+
+type OrderSortField string
+
+const (
+	OrderSortCreatedAt   OrderSortField = "created_at"
+	OrderSortUpdatedAt   OrderSortField = "updated_at"
+	OrderSortTotalWeight OrderSortField = "total_weight"
+)
+
+// OrderSort orders a listing; the zero value is newest first.
+type OrderSort struct {
+	Field     OrderSortField
+	Ascending bool
+}
+
+// OrderCursor is the position of the last order of a keyset page.
+type OrderCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// CashCollectionFilter selects cash-on-delivery collections confirmed in
+// [CollectedFrom, CollectedTo).
+type CashCollectionFilter struct {
+	CollectedFrom time.Time
+	CollectedTo   time.Time
+	DriverID      string
+}
+
 type OrderRepository interface {
 	Create(ctx context.Context, order *domain.Order) error
 	GetByID(ctx context.Context, id string) (*domain.Order, error)
+	GetByTrackingCode(ctx context.Context, code string) (*domain.Order, error)
+	GetDeletedByID(ctx context.Context, id string) (*domain.Order, error)
 	GetByClientID(ctx context.Context, clientID string, limit, offset int) ([]*domain.Order, error)
 	GetAll(ctx context.Context, limit, offset int) ([]*domain.Order, error)
 	Update(ctx context.Context, order *domain.Order) error
+	// Delete removes the order and its dependent rows for good.
 	Delete(ctx context.Context, id string) error
 	GetByStatus(ctx context.Context, status domain.OrderStatus, limit, offset int) ([]*domain.Order, error)
 	UpdateStatus(ctx context.Context, orderID string, status domain.OrderStatus) error
 	CountByClientID(ctx context.Context, clientID string) (int64, error)
 	CountTotal(ctx context.Context) (int64, error)
 	CountByStatus(ctx context.Context, status domain.OrderStatus) (int64, error)
+	List(ctx context.Context, filter OrderFilter, sort OrderSort, limit, offset int) ([]*domain.Order, error)
+	// ListAfter pages by (created_at, id) instead of an offset, starting after
+	// the cursor or at the beginning when it is nil.
+	ListAfter(ctx context.Context, filter OrderFilter, after *OrderCursor, ascending bool, limit int) ([]*domain.Order, error)
+	Count(ctx context.Context, filter OrderFilter) (int64, error)
+	// ListCashCollections loads only the identifying and cash-on-delivery
+	// fields, soft-deleted orders included since the cash was still handled.
+	ListCashCollections(ctx context.Context, filter CashCollectionFilter) ([]*domain.Order, error)
 }
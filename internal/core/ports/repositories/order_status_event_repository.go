@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type OrderStatusEventRepository interface {
+	Create(ctx context.Context, event *domain.OrderStatusEvent) error
+	GetByOrderID(ctx context.Context, orderID string) ([]*domain.OrderStatusEvent, error)
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type ProofOfDeliveryRepository interface {
+	Create(ctx context.Context, proof *domain.ProofOfDelivery) error
+	// GetByOrderID returns nil without an error when the order has no proof.
+	GetByOrderID(ctx context.Context, orderID string) (*domain.ProofOfDelivery, error)
+}
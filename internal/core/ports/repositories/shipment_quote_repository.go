@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+	"time"
+)
+
+type ShipmentQuoteRepository interface {
+	Create(ctx context.Context, quote *domain.ShipmentQuote) error
+	GetByID(ctx context.Context, id string) (*domain.ShipmentQuote, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type WorkflowRepository interface {
+	GetAll(ctx context.Context) ([]*domain.Workflow, error)
+	Save(ctx context.Context, workflow *domain.Workflow) error
+}
@@ -0,0 +1,10 @@
+package services
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type PricingService interface {
+	Quote(ctx context.Context, origin, destination domain.Coordinates, size domain.PackageSize, serviceType domain.ServiceType) (*domain.PriceQuote, error)
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+	"time"
+)
+
+type SLACalculator interface {
+	PromisedDeliveryAt(ctx context.Context, order *domain.Order) (time.Time, error)
+}
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+)
+
+type WorkflowProvider interface {
+	GetWorkflow(ctx context.Context, serviceType domain.ServiceType) (*domain.Workflow, error)
+	GetWorkflows(ctx context.Context) ([]*domain.Workflow, error)
+	SaveWorkflow(ctx context.Context, workflow *domain.Workflow) error
+}
@@ -0,0 +1,158 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/pkg/logger"
+)
+
+const archiveBatchSize = 200
+
+var errOpenClaim = errors.New("order has a claim under review")
+
+type ArchiveOrdersUseCase struct {
+	orderRepo   repositories.OrderRepository
+	eventRepo   repositories.OrderStatusEventRepository
+	attemptRepo repositories.DeliveryAttemptRepository
+	proofRepo   repositories.ProofOfDeliveryRepository
+	noteRepo    repositories.OrderNoteRepository
+	claimRepo   repositories.ClaimRepository
+	archiveRepo repositories.ArchivedOrderRepository
+	txManager   repositories.TransactionManager
+	afterMonths int
+	logger      logger.Logger
+}
+
+func NewArchiveOrdersUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	attemptRepo repositories.DeliveryAttemptRepository,
+	proofRepo repositories.ProofOfDeliveryRepository,
+	noteRepo repositories.OrderNoteRepository,
+	claimRepo repositories.ClaimRepository,
+	archiveRepo repositories.ArchivedOrderRepository,
+	txManager repositories.TransactionManager,
+	afterMonths int,
+	logger logger.Logger,
+) *ArchiveOrdersUseCase {
+	return &ArchiveOrdersUseCase{
+		orderRepo:   orderRepo,
+		eventRepo:   eventRepo,
+		attemptRepo: attemptRepo,
+		proofRepo:   proofRepo,
+		noteRepo:    noteRepo,
+		claimRepo:   claimRepo,
+		archiveRepo: archiveRepo,
+		txManager:   txManager,
+		afterMonths: afterMonths,
+		logger:      logger,
+	}
+}
+
+// Execute moves delivered and cancelled orders closed more than afterMonths
+// ago into the archive, soft-deleted ones included. Orders with a
+// claim under review stay until it is resolved. Each order is archived in its
+// own transaction; one that fails is logged and skipped until the next run.
+func (uc *ArchiveOrdersUseCase) Execute(ctx context.Context) (int, error) {
+	now := time.Now()
+	cutoff := now.AddDate(0, -uc.afterMonths, 0)
+	filter := repositories.OrderFilter{
+		Statuses:       domain.GetArchivableStatuses(),
+		ClosedBefore:   &cutoff,
+		IncludeDeleted: true,
+	}
+
+	uc.logger.Info("Archiving orders", logger.String("closed_before", cutoff.Format(time.RFC3339)))
+
+	archived := 0
+	var after *repositories.OrderCursor
+	for {
+		orders, err := uc.orderRepo.ListAfter(ctx, filter, after, true, archiveBatchSize)
+		if err != nil {
+			uc.logger.Error("Failed to list orders to archive", logger.Error(err))
+			return archived, err
+		}
+
+		for _, order := range orders {
+			if err := uc.archive(ctx, order, now); err != nil {
+				if errors.Is(err, errOpenClaim) {
+					uc.logger.Info("Order has an open claim, not archiving", logger.String("order_id", order.ID))
+					continue
+				}
+				uc.logger.Error("Failed to archive order",
+					logger.String("order_id", order.ID),
+					logger.Error(err),
+				)
+				continue
+			}
+			archived++
+		}
+
+		if len(orders) < archiveBatchSize {
+			break
+		}
+		last := orders[len(orders)-1]
+		after = &repositories.OrderCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	uc.logger.Info("Orders archived", logger.Int("count", archived))
+	return archived, nil
+}
+
+// StartSchedule runs Execute on every tick of interval.
+func (uc *ArchiveOrdersUseCase) StartSchedule(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			if _, err := uc.Execute(context.Background()); err != nil {
+				uc.logger.Error("Scheduled order archival failed", logger.Error(err))
+			}
+		}
+	}()
+}
+
+func (uc *ArchiveOrdersUseCase) archive(ctx context.Context, order *domain.Order, now time.Time) error {
+	claims, err := uc.claimRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	for _, claim := range claims {
+		if claim.IsOpen() {
+			return errOpenClaim
+		}
+	}
+
+	events, err := uc.eventRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	attempts, err := uc.attemptRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	proof, err := uc.proofRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	order.ProofOfDelivery = proof
+	notes, err := uc.noteRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+
+	archivedOrder, err := domain.NewArchivedOrder(order, events, attempts, notes, claims, now)
+	if err != nil {
+		return err
+	}
+
+	return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.archiveRepo.Create(ctx, archivedOrder); err != nil {
+			return err
+		}
+		return uc.orderRepo.Delete(ctx, order.ID)
+	})
+}
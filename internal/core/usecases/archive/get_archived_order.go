@@ -0,0 +1,71 @@
+package archive
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetArchivedOrderUseCase struct {
+	archiveRepo repositories.ArchivedOrderRepository
+	logger      logger.Logger
+}
+
+func NewGetArchivedOrderUseCase(
+	archiveRepo repositories.ArchivedOrderRepository,
+	logger logger.Logger,
+) *GetArchivedOrderUseCase {
+	return &GetArchivedOrderUseCase{
+		archiveRepo: archiveRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *GetArchivedOrderUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole) (*dto.ArchivedOrderResponse, error) {
+	uc.logger.Info("Getting archived order",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+	)
+
+	archivedOrder, err := uc.archiveRepo.GetByID(ctx, orderID)
+	if err != nil || !archivedOrder.CanBeViewedBy(userID, userRole) {
+		uc.logger.Warn("Archived order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, appErrors.NewNotFoundError("archived order")
+	}
+
+	snapshot, err := archivedOrder.DecodeSnapshot()
+	if err != nil {
+		uc.logger.Error("Failed to decode archived order snapshot",
+			logger.String("order_id", orderID),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	visibleNotes := make([]*domain.OrderNote, 0, len(snapshot.Notes))
+	for _, note := range snapshot.Notes {
+		if note.CanBeViewedBy(userRole) {
+			visibleNotes = append(visibleNotes, note)
+		}
+	}
+	snapshot.Notes = visibleNotes
+
+	visibleEvents := make([]*domain.OrderStatusEvent, 0, len(snapshot.Events))
+	for _, event := range snapshot.Events {
+		if event.CanBeViewedBy(userRole) {
+			visibleEvents = append(visibleEvents, event)
+		}
+	}
+	snapshot.Events = visibleEvents
+
+	response := dto.ToArchivedOrderResponse(archivedOrder)
+	response.Snapshot = dto.ToArchivedOrderSnapshot(snapshot)
+	return response, nil
+}
@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"context"
+	"math"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type SearchArchivedOrdersUseCase struct {
+	archiveRepo repositories.ArchivedOrderRepository
+	logger      logger.Logger
+}
+
+func NewSearchArchivedOrdersUseCase(
+	archiveRepo repositories.ArchivedOrderRepository,
+	logger logger.Logger,
+) *SearchArchivedOrdersUseCase {
+	return &SearchArchivedOrdersUseCase{
+		archiveRepo: archiveRepo,
+		logger:      logger,
+	}
+}
+
+// Execute searches the archive. Clients only ever see their own orders, and
+// never the ones an admin deleted.
+func (uc *SearchArchivedOrdersUseCase) Execute(ctx context.Context, userID string, userRole domain.UserRole, req dto.SearchArchivedOrdersRequest) (*dto.ListArchivedOrdersResponse, error) {
+	uc.logger.Info("Searching archived orders", logger.String("user_id", userID))
+
+	filter := repositories.ArchivedOrderFilter{
+		ClientID:        req.ClientID,
+		TrackingCode:    domain.NormalizeTrackingCode(req.TrackingCode),
+		Statuses:        req.Statuses,
+		CreatedFrom:     req.CreatedFrom,
+		CreatedTo:       req.CreatedTo,
+		OriginCity:      req.OriginCity,
+		DestinationCity: req.DestinationCity,
+		IncludeDeleted:  userRole == domain.AdminRole,
+	}
+	if userRole != domain.AdminRole {
+		if req.ClientID != "" && req.ClientID != userID {
+			uc.logger.Warn("Client attempted to search another client's archive", logger.String("user_id", userID))
+			return nil, appErrors.NewForbiddenError()
+		}
+		filter.ClientID = userID
+	}
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 || req.Limit > 100 {
+		req.Limit = 10
+	}
+	offset := (req.Page - 1) * req.Limit
+
+	orders, err := uc.archiveRepo.Search(ctx, filter, req.Limit, offset)
+	if err != nil {
+		uc.logger.Error("Failed to search archived orders", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	total, err := uc.archiveRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count archived orders", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	return &dto.ListArchivedOrdersResponse{
+		Orders:     dto.ToArchivedOrderResponseList(orders),
+		Total:      total,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+	}, nil
+}
@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+type SearchArchivedOrdersRequest struct {
+	Page            int                  `json:"page" validate:"min=1"`
+	Limit           int                  `json:"limit" validate:"min=1,max=100"`
+	ClientID        string               `json:"client_id,omitempty" validate:"omitempty,max=64"`
+	TrackingCode    string               `json:"tracking_code,omitempty" validate:"max=20"`
+	Statuses        []domain.OrderStatus `json:"status,omitempty" validate:"omitempty,max=2,dive,oneof=entregado cancelado"`
+	CreatedFrom     *time.Time           `json:"created_from,omitempty"`
+	CreatedTo       *time.Time           `json:"created_to,omitempty"`
+	OriginCity      string               `json:"origin_city,omitempty" validate:"max=100"`
+	DestinationCity string               `json:"destination_city,omitempty" validate:"max=100"`
+}
+
+// ArchivedOrderResponse carries the full snapshot only when a single archived
+// order is requested.
+type ArchivedOrderResponse struct {
+	ID              string                 `json:"id"`
+	TrackingCode    string                 `json:"tracking_code"`
+	ClientID        string                 `json:"client_id"`
+	Status          domain.OrderStatus     `json:"status"`
+	ServiceType     domain.ServiceType     `json:"service_type"`
+	PackageSize     domain.PackageSize     `json:"package_size"`
+	OriginCity      string                 `json:"origin_city"`
+	DestinationCity string                 `json:"destination_city"`
+	TotalWeight     float64                `json:"total_weight"`
+	Price           float64                `json:"price,omitempty"`
+	Currency        string                 `json:"currency,omitempty"`
+	OrderCreatedAt  string                 `json:"order_created_at"`
+	ClosedAt        string                 `json:"closed_at"`
+	DeletedAt       string                 `json:"deleted_at,omitempty"`
+	ArchivedAt      string                 `json:"archived_at"`
+	Snapshot        *ArchivedOrderSnapshot `json:"snapshot,omitempty"`
+}
+
+type ArchivedOrderSnapshot struct {
+	Order            *OrderResponse              `json:"order"`
+	History          []*OrderStatusEventResponse `json:"history"`
+	DeliveryAttempts []*DeliveryAttemptResponse  `json:"delivery_attempts"`
+	Notes            []*OrderNoteResponse        `json:"notes"`
+	Claims           []*ClaimResponse            `json:"claims"`
+}
+
+type ListArchivedOrdersResponse struct {
+	Orders     []*ArchivedOrderResponse `json:"orders"`
+	Total      int64                    `json:"total"`
+	Page       int                      `json:"page"`
+	Limit      int                      `json:"limit"`
+	TotalPages int                      `json:"total_pages"`
+}
+
+func ToArchivedOrderResponse(order *domain.ArchivedOrder) *ArchivedOrderResponse {
+	response := &ArchivedOrderResponse{
+		ID:              order.ID,
+		TrackingCode:    order.TrackingCode,
+		ClientID:        order.ClientID,
+		Status:          order.Status,
+		ServiceType:     order.ServiceType,
+		PackageSize:     order.PackageSize,
+		OriginCity:      order.OriginCity,
+		DestinationCity: order.DestinationCity,
+		TotalWeight:     order.TotalWeight,
+		Price:           order.Price,
+		Currency:        order.Currency,
+		OrderCreatedAt:  order.OrderCreatedAt.Format(time.RFC3339),
+		ClosedAt:        order.ClosedAt.Format(time.RFC3339),
+		ArchivedAt:      order.ArchivedAt.Format(time.RFC3339),
+	}
+
+	if order.DeletedAt != nil {
+		response.DeletedAt = order.DeletedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+func ToArchivedOrderSnapshot(snapshot *domain.ArchivedOrderSnapshot) *ArchivedOrderSnapshot {
+	history := make([]*OrderStatusEventResponse, len(snapshot.Events))
+	for i, event := range snapshot.Events {
+		history[i] = ToOrderStatusEventResponse(event)
+	}
+
+	return &ArchivedOrderSnapshot{
+		Order:            ToOrderResponse(snapshot.Order),
+		History:          history,
+		DeliveryAttempts: ToDeliveryAttemptResponseList(snapshot.DeliveryAttempts),
+		Notes:            ToOrderNoteResponseList(snapshot.Notes),
+		Claims:           ToClaimResponseList(snapshot.Claims),
+	}
+}
+
+func ToArchivedOrderResponseList(orders []*domain.ArchivedOrder) []*ArchivedOrderResponse {
+	responses := make([]*ArchivedOrderResponse, len(orders))
+	for i, order := range orders {
+		responses[i] = ToArchivedOrderResponse(order)
+	}
+	return responses
+}
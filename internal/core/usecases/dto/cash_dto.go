@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+// CashReconciliationRequest covers the calendar days From through To, both
+// inclusive, in TimeZone.
+type CashReconciliationRequest struct {
+	From     string `json:"from" validate:"required,datetime=2006-01-02"`
+	To       string `json:"to" validate:"required,datetime=2006-01-02"`
+	DriverID string `json:"driver_id,omitempty" validate:"max=64"`
+	TimeZone string `json:"time_zone,omitempty" validate:"max=64"`
+}
+
+// DriverCashDay is what one driver collected on one day in one currency.
+type DriverCashDay struct {
+	DriverID       string                     `json:"driver_id"`
+	Date           string                     `json:"date"`
+	Currency       string                     `json:"currency"`
+	Orders         int                        `json:"orders"`
+	Expected       float64                    `json:"expected"`
+	Collected      float64                    `json:"collected"`
+	Difference     float64                    `json:"difference"`
+	HasDiscrepancy bool                       `json:"has_discrepancy"`
+	Discrepancies  []*CashDiscrepancyResponse `json:"discrepancies,omitempty"`
+}
+
+type CashDiscrepancyResponse struct {
+	OrderID      string  `json:"order_id"`
+	TrackingCode string  `json:"tracking_code"`
+	Expected     float64 `json:"expected"`
+	Collected    float64 `json:"collected"`
+	Difference   float64 `json:"difference"`
+	CollectedAt  string  `json:"collected_at"`
+}
+
+type CashReconciliationResponse struct {
+	From     string           `json:"from"`
+	To       string           `json:"to"`
+	TimeZone string           `json:"time_zone"`
+	Days     []*DriverCashDay `json:"days"`
+}
+
+func ToCashDiscrepancyResponse(order *domain.Order) *CashDiscrepancyResponse {
+	return &CashDiscrepancyResponse{
+		OrderID:      order.ID,
+		TrackingCode: order.TrackingCode,
+		Expected:     order.CODAmount,
+		Collected:    *order.CODCollectedAmount,
+		Difference:   order.CashDiscrepancy(),
+		CollectedAt:  order.CODCollectedAt.Format(time.RFC3339),
+	}
+}
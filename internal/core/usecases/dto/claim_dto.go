@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+type FileClaimRequest struct {
+	Type          domain.ClaimType `json:"type" validate:"required,oneof=loss damage"`
+	Description   string           `json:"description" validate:"required,max=2000"`
+	ClaimedAmount float64          `json:"claimed_amount" validate:"required,gt=0,max=10000000"`
+}
+
+// ResolveClaimRequest approves or rejects a claim. ApprovedAmount is required
+// to approve; a note is required to reject.
+type ResolveClaimRequest struct {
+	Status         domain.ClaimStatus `json:"status" validate:"required,oneof=approved rejected"`
+	ApprovedAmount *float64           `json:"approved_amount,omitempty" validate:"omitempty,gt=0"`
+	Note           string             `json:"note,omitempty" validate:"max=2000"`
+}
+
+type ListClaimsRequest struct {
+	Page     int                `json:"page" validate:"min=1"`
+	Limit    int                `json:"limit" validate:"min=1,max=100"`
+	Status   domain.ClaimStatus `json:"status,omitempty" validate:"omitempty,oneof=submitted approved rejected"`
+	ClientID string             `json:"client_id,omitempty" validate:"omitempty,max=64"`
+}
+
+type ClaimResponse struct {
+	ID             string             `json:"id"`
+	OrderID        string             `json:"order_id"`
+	ClientID       string             `json:"client_id"`
+	Type           domain.ClaimType   `json:"type"`
+	Description    string             `json:"description"`
+	ClaimedAmount  float64            `json:"claimed_amount"`
+	Currency       string             `json:"currency"`
+	DeclaredValue  float64            `json:"declared_value"`
+	Insured        bool               `json:"insured"`
+	Coverage       float64            `json:"coverage,omitempty"`
+	Status         domain.ClaimStatus `json:"status"`
+	ApprovedAmount *float64           `json:"approved_amount,omitempty"`
+	ResolvedBy     string             `json:"resolved_by,omitempty"`
+	ResolutionNote string             `json:"resolution_note,omitempty"`
+	CreatedAt      string             `json:"created_at"`
+	ResolvedAt     string             `json:"resolved_at,omitempty"`
+}
+
+type ListClaimsResponse struct {
+	Claims     []*ClaimResponse `json:"claims"`
+	Total      int64            `json:"total"`
+	Page       int              `json:"page"`
+	Limit      int              `json:"limit"`
+	TotalPages int              `json:"total_pages"`
+}
+
+func ToClaimResponse(claim *domain.Claim) *ClaimResponse {
+	response := &ClaimResponse{
+		ID:             claim.ID,
+		OrderID:        claim.OrderID,
+		ClientID:       claim.ClientID,
+		Type:           claim.Type,
+		Description:    claim.Description,
+		ClaimedAmount:  claim.ClaimedAmount,
+		Currency:       claim.Currency,
+		DeclaredValue:  claim.DeclaredValue,
+		Insured:        claim.Insured,
+		Coverage:       claim.Coverage,
+		Status:         claim.Status,
+		ApprovedAmount: claim.ApprovedAmount,
+		ResolvedBy:     claim.ResolvedBy,
+		ResolutionNote: claim.ResolutionNote,
+		CreatedAt:      claim.CreatedAt.Format(time.RFC3339),
+	}
+
+	if claim.ResolvedAt != nil {
+		response.ResolvedAt = claim.ResolvedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+func ToClaimResponseList(claims []*domain.Claim) []*ClaimResponse {
+	responses := make([]*ClaimResponse, len(claims))
+	for i, claim := range claims {
+		responses[i] = ToClaimResponse(claim)
+	}
+	return responses
+}
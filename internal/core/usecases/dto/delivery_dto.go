@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"logistics-api/internal/core/domain"
+	"time"
+)
+
+type RecordDeliveryAttemptRequest struct {
+	ReasonCode domain.DeliveryFailureReason `json:"reason_code" validate:"required,oneof=no_one_home wrong_address refused inaccessible other"`
+	Notes      string                       `json:"notes,omitempty" validate:"max=500"`
+	Location   string                       `json:"location,omitempty" validate:"max=255"`
+}
+
+type DeliveryAttemptResponse struct {
+	ID            string                       `json:"id"`
+	AttemptNumber int                          `json:"attempt_number"`
+	ReasonCode    domain.DeliveryFailureReason `json:"reason_code"`
+	Notes         string                       `json:"notes,omitempty"`
+	ActorID       string                       `json:"actor_id"`
+	AttemptedAt   string                       `json:"attempted_at"`
+}
+
+type RecordDeliveryAttemptResponse struct {
+	Attempt *DeliveryAttemptResponse `json:"attempt"`
+	Order   *OrderResponse           `json:"order"`
+}
+
+func ToDeliveryAttemptResponse(attempt *domain.DeliveryAttempt) *DeliveryAttemptResponse {
+	return &DeliveryAttemptResponse{
+		ID:            attempt.ID,
+		AttemptNumber: attempt.AttemptNumber,
+		ReasonCode:    attempt.ReasonCode,
+		Notes:         attempt.Notes,
+		ActorID:       attempt.ActorID,
+		AttemptedAt:   attempt.AttemptedAt.Format(time.RFC3339),
+	}
+}
+
+func ToDeliveryAttemptResponseList(attempts []*domain.DeliveryAttempt) []*DeliveryAttemptResponse {
+	responses := make([]*DeliveryAttemptResponse, len(attempts))
+	for i, attempt := range attempts {
+		responses[i] = ToDeliveryAttemptResponse(attempt)
+	}
+	return responses
+}
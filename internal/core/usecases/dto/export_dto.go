@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+// ExportOrdersRequest reuses the listing filters; pagination fields are
+// ignored since the export covers every matching order.
+type ExportOrdersRequest struct {
+	Filters  ListOrdersRequest
+	Format   string   `validate:"required,oneof=csv ndjson"`
+	Columns  []string `validate:"omitempty,max=50"`
+	TimeZone string   `validate:"max=64"`
+}
+
+// OrderExportSink receives the export as it is read. Values are strings,
+// numbers or nil, so each format can render them natively.
+type OrderExportSink interface {
+	WriteHeader(columns []string) error
+	WriteRow(values []interface{}) error
+	Flush() error
+}
+
+type exportColumn func(order *domain.Order, loc *time.Location) interface{}
+
+var exportColumns = map[string]exportColumn{
+	"id":                   func(o *domain.Order, _ *time.Location) interface{} { return o.ID },
+	"tracking_code":        func(o *domain.Order, _ *time.Location) interface{} { return o.TrackingCode },
+	"client_id":            func(o *domain.Order, _ *time.Location) interface{} { return o.ClientID },
+	"client_email":         func(o *domain.Order, _ *time.Location) interface{} { return o.Client.Email },
+	"status":               func(o *domain.Order, _ *time.Location) interface{} { return string(o.Status) },
+	"service_type":         func(o *domain.Order, _ *time.Location) interface{} { return string(o.ServiceType) },
+	"package_size":         func(o *domain.Order, _ *time.Location) interface{} { return string(o.PackageSize) },
+	"product_quantity":     func(o *domain.Order, _ *time.Location) interface{} { return o.ProductQuantity },
+	"parcel_count":         func(o *domain.Order, _ *time.Location) interface{} { return len(o.Parcels) },
+	"total_weight":         func(o *domain.Order, _ *time.Location) interface{} { return o.TotalWeight },
+	"billable_weight":      func(o *domain.Order, _ *time.Location) interface{} { return o.BillableWeight },
+	"price":                func(o *domain.Order, _ *time.Location) interface{} { return o.Price },
+	"currency":             func(o *domain.Order, _ *time.Location) interface{} { return o.Currency },
+	"cod_amount":           func(o *domain.Order, _ *time.Location) interface{} { return o.CODAmount },
+	"cod_currency":         func(o *domain.Order, _ *time.Location) interface{} { return o.CODCurrency },
+	"cod_collected_amount": func(o *domain.Order, _ *time.Location) interface{} { return exportAmount(o.CODCollectedAmount) },
+	"declared_value":       func(o *domain.Order, _ *time.Location) interface{} { return o.DeclaredValue },
+	"insured":              func(o *domain.Order, _ *time.Location) interface{} { return o.Insured },
+	"insurance_premium":    func(o *domain.Order, _ *time.Location) interface{} { return o.InsurancePremium },
+	"delivery_attempts":    func(o *domain.Order, _ *time.Location) interface{} { return o.DeliveryAttempts },
+	"origin_address":       func(o *domain.Order, _ *time.Location) interface{} { return o.OriginAddress.FullAddress() },
+	"origin_city":          func(o *domain.Order, _ *time.Location) interface{} { return o.OriginAddress.City },
+	"origin_state":         func(o *domain.Order, _ *time.Location) interface{} { return o.OriginAddress.State },
+	"origin_zipcode":       func(o *domain.Order, _ *time.Location) interface{} { return o.OriginAddress.ZipCode },
+	"destination_address":  func(o *domain.Order, _ *time.Location) interface{} { return o.DestinationAddress.FullAddress() },
+	"destination_city":     func(o *domain.Order, _ *time.Location) interface{} { return o.DestinationAddress.City },
+	"destination_state":    func(o *domain.Order, _ *time.Location) interface{} { return o.DestinationAddress.State },
+	"destination_zipcode":  func(o *domain.Order, _ *time.Location) interface{} { return o.DestinationAddress.ZipCode },
+	"sender_name":          func(o *domain.Order, _ *time.Location) interface{} { return exportContact(o.SenderContact).Name },
+	"sender_phone":         func(o *domain.Order, _ *time.Location) interface{} { return exportContact(o.SenderContact).Phone },
+	"recipient_name":       func(o *domain.Order, _ *time.Location) interface{} { return exportContact(o.RecipientContact).Name },
+	"recipient_phone":      func(o *domain.Order, _ *time.Location) interface{} { return exportContact(o.RecipientContact).Phone },
+	"promised_delivery_at": func(o *domain.Order, loc *time.Location) interface{} { return exportTime(o.PromisedDeliveryAt, loc) },
+	"created_at":           func(o *domain.Order, loc *time.Location) interface{} { return exportTime(&o.CreatedAt, loc) },
+	"updated_at":           func(o *domain.Order, loc *time.Location) interface{} { return exportTime(&o.UpdatedAt, loc) },
+}
+
+// DefaultExportColumns is used when the request doesn't pick columns.
+var DefaultExportColumns = []string{
+	"id", "tracking_code", "client_id", "status", "service_type", "package_size",
+	"product_quantity", "total_weight", "billable_weight", "price", "currency",
+	"origin_city", "origin_state", "origin_zipcode",
+	"destination_city", "destination_state", "destination_zipcode",
+	"promised_delivery_at", "created_at", "updated_at",
+}
+
+// ValidateExportColumns rejects unknown or repeated column names.
+func ValidateExportColumns(columns []string) error {
+	seen := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if _, ok := exportColumns[column]; !ok {
+			return fmt.Errorf("unknown export column %q", column)
+		}
+		if seen[column] {
+			return fmt.Errorf("duplicate export column %q", column)
+		}
+		seen[column] = true
+	}
+	return nil
+}
+
+func ToExportRow(order *domain.Order, columns []string, loc *time.Location) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i, column := range columns {
+		values[i] = exportColumns[column](order, loc)
+	}
+	return values
+}
+
+func exportTime(t *time.Time, loc *time.Location) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+func exportAmount(amount *float64) interface{} {
+	if amount == nil {
+		return nil
+	}
+	return *amount
+}
+
+func exportContact(contact *domain.Contact) domain.Contact {
+	if contact == nil {
+		return domain.Contact{}
+	}
+	return *contact
+}
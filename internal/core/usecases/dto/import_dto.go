@@ -0,0 +1,287 @@
+package dto
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+// ImportOrderRow is one parsed row of a bulk import. Rows that could not be
+// parsed carry ParseError instead of a request and are reported as failed.
+type ImportOrderRow struct {
+	Row        int
+	Request    CreateOrderRequest
+	ParseError string
+}
+
+type ImportJobResponse struct {
+	ID         string                   `json:"id"`
+	Status     domain.ImportJobStatus   `json:"status"`
+	StatusURL  string                   `json:"status_url,omitempty"`
+	TotalRows  int                      `json:"total_rows"`
+	Processed  int                      `json:"processed"`
+	Succeeded  int                      `json:"succeeded"`
+	Failed     int                      `json:"failed"`
+	Results    []domain.ImportRowResult `json:"results"`
+	Error      string                   `json:"error,omitempty"`
+	CreatedAt  string                   `json:"created_at"`
+	StartedAt  string                   `json:"started_at,omitempty"`
+	FinishedAt string                   `json:"finished_at,omitempty"`
+}
+
+func ToImportJobResponse(job *domain.ImportJob) *ImportJobResponse {
+	response := &ImportJobResponse{
+		ID:        job.ID,
+		Status:    job.Status,
+		TotalRows: job.TotalRows,
+		Processed: job.Processed(),
+		Succeeded: job.Succeeded,
+		Failed:    job.Failed,
+		Results:   job.Results,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.Format(time.RFC3339),
+	}
+
+	if response.Results == nil {
+		response.Results = []domain.ImportRowResult{}
+	}
+
+	if job.StartedAt != nil {
+		response.StartedAt = job.StartedAt.Format(time.RFC3339)
+	}
+
+	if job.FinishedAt != nil {
+		response.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+// requiredImportCSVColumns must appear in the CSV header, in any order. The
+// optional columns are origin_int_num, destination_int_num, length_cm,
+// width_cm, height_cm, service_type, cod_amount, cod_currency,
+// declared_value, declared_value_currency, insured, the sender_/recipient_
+// name, phone, email and instructions contact columns and the
+// pickup_/delivery_ start, end and time_zone window columns.
+var requiredImportCSVColumns = []string{
+	"origin_latitude", "origin_longitude",
+	"origin_street", "origin_ext_num", "origin_zipcode", "origin_city", "origin_state", "origin_country",
+	"destination_latitude", "destination_longitude",
+	"destination_street", "destination_ext_num", "destination_zipcode", "destination_city", "destination_state", "destination_country",
+	"product_quantity", "total_weight",
+}
+
+// ParseImportCSV reads one order per line. A malformed header fails the whole
+// file; a malformed value only fails its row.
+func ParseImportCSV(r io.Reader, maxRows int) ([]ImportOrderRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv file is empty")
+		}
+		return nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, exists := columns[name]; exists {
+			return nil, fmt.Errorf("duplicate csv column %q", name)
+		}
+		columns[name] = i
+	}
+
+	var missing []string
+	for _, name := range requiredImportCSVColumns {
+		if _, ok := columns[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing csv columns: %s", strings.Join(missing, ", "))
+	}
+
+	var rows []ImportOrderRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		row := ImportOrderRow{Row: len(rows) + 1}
+		if len(rows) == maxRows {
+			return nil, fmt.Errorf("import exceeds the limit of %d rows", maxRows)
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read csv: %w", err)
+			}
+			row.ParseError = parseErr.Err.Error()
+		} else {
+			row.Request, err = csvRecordToRequest(record, columns)
+			if err != nil {
+				row.ParseError = err.Error()
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New("csv file has no rows")
+	}
+
+	return rows, nil
+}
+
+func csvRecordToRequest(record []string, columns map[string]int) (CreateOrderRequest, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var req CreateOrderRequest
+	var errs []string
+	number := func(name string) float64 {
+		value := field(name)
+		if value == "" {
+			return 0
+		}
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s is not a number", name))
+		}
+		return f
+	}
+
+	req.OriginCoordinates = domain.Coordinates{Latitude: number("origin_latitude"), Longitude: number("origin_longitude")}
+	req.DestinationCoordinates = domain.Coordinates{Latitude: number("destination_latitude"), Longitude: number("destination_longitude")}
+	req.OriginAddress = csvAddress(field, "origin_")
+	req.DestinationAddress = csvAddress(field, "destination_")
+	req.SenderContact = csvContact(field, "sender_")
+	req.RecipientContact = csvContact(field, "recipient_")
+
+	if value := field("product_quantity"); value != "" {
+		quantity, err := strconv.Atoi(value)
+		if err != nil {
+			errs = append(errs, "product_quantity is not an integer")
+		}
+		req.ProductQuantity = quantity
+	}
+
+	req.TotalWeight = number("total_weight")
+	if field("length_cm") != "" || field("width_cm") != "" || field("height_cm") != "" {
+		req.Dimensions = &domain.Dimensions{
+			LengthCm: number("length_cm"),
+			WidthCm:  number("width_cm"),
+			HeightCm: number("height_cm"),
+		}
+	}
+
+	req.ServiceType = domain.ServiceType(field("service_type"))
+	req.PickupWindow = csvTimeWindow(field, "pickup_")
+	req.DeliveryWindow = csvTimeWindow(field, "delivery_")
+
+	if field("cod_amount") != "" {
+		amount := number("cod_amount")
+		req.CODAmount = &amount
+	}
+	req.CODCurrency = field("cod_currency")
+
+	if field("declared_value") != "" {
+		value := number("declared_value")
+		req.DeclaredValue = &value
+	}
+	req.DeclaredValueCurrency = field("declared_value_currency")
+	if value := field("insured"); value != "" {
+		insured, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = append(errs, "insured is not a boolean")
+		}
+		req.Insured = insured
+	}
+
+	if len(errs) > 0 {
+		return req, errors.New(strings.Join(errs, "; "))
+	}
+	return req, nil
+}
+
+// csvContact returns nil when the row leaves every column of the contact empty.
+func csvContact(field func(string) string, prefix string) *domain.Contact {
+	contact := domain.Contact{
+		Name:         field(prefix + "name"),
+		Phone:        field(prefix + "phone"),
+		Email:        field(prefix + "email"),
+		Instructions: field(prefix + "instructions"),
+	}
+	if contact == (domain.Contact{}) {
+		return nil
+	}
+	return &contact
+}
+
+func csvAddress(field func(string) string, prefix string) domain.Address {
+	return domain.Address{
+		Street:  field(prefix + "street"),
+		ExtNum:  field(prefix + "ext_num"),
+		IntNum:  field(prefix + "int_num"),
+		ZipCode: field(prefix + "zipcode"),
+		City:    field(prefix + "city"),
+		State:   field(prefix + "state"),
+		Country: field(prefix + "country"),
+	}
+}
+
+func csvTimeWindow(field func(string) string, prefix string) *TimeWindowRequest {
+	window := TimeWindowRequest{
+		Start:    field(prefix + "start"),
+		End:      field(prefix + "end"),
+		TimeZone: field(prefix + "time_zone"),
+	}
+	if window == (TimeWindowRequest{}) {
+		return nil
+	}
+	return &window
+}
+
+// ParseImportJSON reads a JSON array of create-order requests. Elements that
+// don't decode fail only their own row.
+func ParseImportJSON(r io.Reader, maxRows int) ([]ImportOrderRow, error) {
+	var elements []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&elements); err != nil {
+		return nil, errors.New("body must be a JSON array of orders")
+	}
+
+	if len(elements) == 0 {
+		return nil, errors.New("import has no rows")
+	}
+
+	if len(elements) > maxRows {
+		return nil, fmt.Errorf("import exceeds the limit of %d rows", maxRows)
+	}
+
+	rows := make([]ImportOrderRow, len(elements))
+	for i, element := range elements {
+		rows[i].Row = i + 1
+		if err := json.Unmarshal(element, &rows[i].Request); err != nil {
+			rows[i].ParseError = "invalid order format"
+		}
+	}
+
+	return rows, nil
+}
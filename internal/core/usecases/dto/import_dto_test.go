@@ -0,0 +1,107 @@
+package dto
+
+import (
+	"strings"
+	"testing"
+)
+
+const importCSVHeader = "origin_latitude,origin_longitude,origin_street,origin_ext_num,origin_zipcode,origin_city,origin_state,origin_country," +
+	"destination_latitude,destination_longitude,destination_street,destination_ext_num,destination_zipcode,destination_city,destination_state,destination_country," +
+	"product_quantity,total_weight"
+
+const importCSVRow = "19.43,-99.13,Reforma,222,06600,CDMX,CDMX,MX,20.66,-103.35,Juarez,10,44100,Guadalajara,Jalisco,MX,2,1.5"
+
+func TestParseImportCSV(t *testing.T) {
+	input := "\ufeffORIGIN_LATITUDE" + importCSVHeader[len("origin_latitude"):] + ",length_cm,width_cm,height_cm,recipient_name,recipient_phone,insured\n" +
+		importCSVRow + ",30,20,10,Ana López,+5215512345678,true\n" +
+		importCSVRow + ",,,,,,\n"
+
+	rows, err := ParseImportCSV(strings.NewReader(input), 10)
+	if err != nil {
+		t.Fatalf("ParseImportCSV() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("rows = %d, want 2", len(rows))
+	}
+
+	first := rows[0]
+	if first.Row != 1 || first.ParseError != "" {
+		t.Fatalf("first row = %+v, want row 1 without errors", first)
+	}
+	req := first.Request
+	if req.OriginCoordinates.Latitude != 19.43 || req.DestinationAddress.City != "Guadalajara" {
+		t.Errorf("request = %+v, coordinates or address not parsed", req)
+	}
+	if req.ProductQuantity != 2 || req.TotalWeight != 1.5 {
+		t.Errorf("quantity = %d, weight = %v, want 2 and 1.5", req.ProductQuantity, req.TotalWeight)
+	}
+	if req.Dimensions == nil || req.Dimensions.LengthCm != 30 || req.Dimensions.HeightCm != 10 {
+		t.Errorf("Dimensions = %+v, want 30x20x10", req.Dimensions)
+	}
+	if req.RecipientContact == nil || req.RecipientContact.Name != "Ana López" {
+		t.Errorf("RecipientContact = %+v, want Ana López", req.RecipientContact)
+	}
+	if req.SenderContact != nil {
+		t.Errorf("SenderContact = %+v, want nil for empty columns", req.SenderContact)
+	}
+	if !req.Insured {
+		t.Error("Insured = false, want true")
+	}
+
+	second := rows[1].Request
+	if second.Dimensions != nil || second.RecipientContact != nil || second.Insured {
+		t.Errorf("second row = %+v, want optional fields left empty", second)
+	}
+}
+
+func TestParseImportCSVRowErrors(t *testing.T) {
+	badNumber := strings.Replace(importCSVRow, "1.5", "heavy", 1)
+	badQuantity := strings.Replace(importCSVRow, ",2,", ",two,", 1)
+	input := importCSVHeader + "\n" +
+		badNumber + "\n" +
+		importCSVRow + ",extra\n" +
+		badQuantity + "\n" +
+		importCSVRow + "\n"
+
+	rows, err := ParseImportCSV(strings.NewReader(input), 10)
+	if err != nil {
+		t.Fatalf("ParseImportCSV() error = %v", err)
+	}
+
+	want := []string{"total_weight is not a number", "wrong number of fields", "product_quantity is not an integer", ""}
+	if len(rows) != len(want) {
+		t.Fatalf("rows = %d, want %d", len(rows), len(want))
+	}
+	for i, row := range rows {
+		if row.Row != i+1 {
+			t.Errorf("rows[%d].Row = %d, want %d", i, row.Row, i+1)
+		}
+		if (want[i] == "") != (row.ParseError == "") || !strings.Contains(row.ParseError, want[i]) {
+			t.Errorf("rows[%d].ParseError = %q, want %q", i, row.ParseError, want[i])
+		}
+	}
+}
+
+func TestParseImportCSVFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		maxRows int
+		want    string
+	}{
+		{name: "empty file", input: "", maxRows: 10, want: "csv file is empty"},
+		{name: "header only", input: importCSVHeader + "\n", maxRows: 10, want: "csv file has no rows"},
+		{name: "missing column", input: strings.Replace(importCSVHeader, ",total_weight", "", 1) + "\n", maxRows: 10, want: "missing csv columns: total_weight"},
+		{name: "duplicate column", input: importCSVHeader + ",Total_Weight\n", maxRows: 10, want: `duplicate csv column "total_weight"`},
+		{name: "too many rows", input: importCSVHeader + "\n" + importCSVRow + "\n" + importCSVRow + "\n", maxRows: 1, want: "import exceeds the limit of 1 rows"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseImportCSV(strings.NewReader(tt.input), tt.maxRows)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("ParseImportCSV() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
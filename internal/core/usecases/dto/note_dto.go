@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"logistics-api/internal/core/domain"
+	"time"
+)
+
+type AddOrderNoteRequest struct {
+	Body       string                `json:"body" validate:"required,max=2000"`
+	Visibility domain.NoteVisibility `json:"visibility,omitempty" validate:"omitempty,oneof=internal client"`
+}
+
+type OrderNoteResponse struct {
+	ID         string                `json:"id"`
+	AuthorID   string                `json:"author_id"`
+	AuthorRole domain.UserRole       `json:"author_role"`
+	Visibility domain.NoteVisibility `json:"visibility"`
+	Body       string                `json:"body"`
+	CreatedAt  string                `json:"created_at"`
+}
+
+func ToOrderNoteResponse(note *domain.OrderNote) *OrderNoteResponse {
+	return &OrderNoteResponse{
+		ID:         note.ID,
+		AuthorID:   note.AuthorID,
+		AuthorRole: note.AuthorRole,
+		Visibility: note.Visibility,
+		Body:       note.Body,
+		CreatedAt:  note.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToOrderNoteResponseList(notes []*domain.OrderNote) []*OrderNoteResponse {
+	responses := make([]*OrderNoteResponse, len(notes))
+	for i, note := range notes {
+		responses[i] = ToOrderNoteResponse(note)
+	}
+	return responses
+}
@@ -10,67 +10,393 @@ type CreateOrderRequest struct {
 	DestinationCoordinates domain.Coordinates `json:"destination_coordinates" validate:"required"`
 	OriginAddress          domain.Address     `json:"origin_address" validate:"required"`
 	DestinationAddress     domain.Address     `json:"destination_address" validate:"required"`
+	SenderContact          *domain.Contact    `json:"sender_contact,omitempty" validate:"omitempty"`
+	RecipientContact       *domain.Contact    `json:"recipient_contact,omitempty" validate:"omitempty"`
 	ProductQuantity        int                `json:"product_quantity" validate:"required,min=1"`
-	TotalWeight            float64            `json:"total_weight" validate:"required,min=0.1"`
+	TotalWeight            float64            `json:"total_weight" validate:"required_without=Parcels,omitempty,min=0.1"`
+	Dimensions             *domain.Dimensions `json:"dimensions,omitempty" validate:"omitempty"`
+	Parcels                []ParcelRequest    `json:"parcels,omitempty" validate:"omitempty,min=1,max=50,dive"`
+	ServiceType            domain.ServiceType `json:"service_type,omitempty" validate:"omitempty,oneof=standard express"`
+	PickupWindow           *TimeWindowRequest `json:"pickup_window,omitempty" validate:"omitempty"`
+	DeliveryWindow         *TimeWindowRequest `json:"delivery_window,omitempty" validate:"omitempty"`
+	CODAmount              *float64           `json:"cod_amount,omitempty" validate:"omitempty,gt=0,max=1000000"`
+	CODCurrency            string             `json:"cod_currency,omitempty" validate:"omitempty,len=3,alpha"`
+	DeclaredValue          *float64           `json:"declared_value,omitempty" validate:"omitempty,gt=0,max=10000000"`
+	DeclaredValueCurrency  string             `json:"declared_value_currency,omitempty" validate:"omitempty,len=3,alpha"`
+	Insured                bool               `json:"insured,omitempty"`
+	QuoteID                string             `json:"quote_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// TimeWindowRequest bounds are RFC3339 timestamps or local times
+// (2006-01-02T15:04:05) in TimeZone.
+type TimeWindowRequest struct {
+	Start    string `json:"start" validate:"required"`
+	End      string `json:"end" validate:"required"`
+	TimeZone string `json:"time_zone" validate:"required"`
+}
+
+type ParcelRequest struct {
+	Weight     float64            `json:"weight" validate:"required,min=0.1"`
+	Dimensions *domain.Dimensions `json:"dimensions,omitempty" validate:"omitempty"`
+}
+
+type UpdateParcelStatusRequest struct {
+	Status   domain.OrderStatus `json:"status" validate:"required,oneof=recolectado en_estacion en_ruta entregado"`
+	Location string             `json:"location,omitempty" validate:"max=255"`
 }
 
 type UpdateOrderStatusRequest struct {
-	Status domain.OrderStatus `json:"status" validate:"required,oneof=creado recolectado en_estacion en_ruta entregado cancelado"`
+	Status   domain.OrderStatus `json:"status" validate:"required,oneof=creado recolectado en_estacion en_ruta entregado cancelado en_devolucion devuelto entregado_parcial pendiente_cotizacion cotizado"`
+	Reason   string             `json:"reason,omitempty" validate:"max=500"`
+	Location string             `json:"location,omitempty" validate:"max=255"`
+}
+
+// BatchUpdateStatusRequest references orders by ID or by tracking code.
+type BatchUpdateStatusRequest struct {
+	References []string           `json:"references" validate:"required,min=1,max=500,dive,required,max=64"`
+	Status     domain.OrderStatus `json:"status" validate:"required,oneof=creado recolectado en_estacion en_ruta entregado cancelado en_devolucion devuelto entregado_parcial pendiente_cotizacion cotizado"`
+	Reason     string             `json:"reason,omitempty" validate:"max=500"`
+	Location   string             `json:"location,omitempty" validate:"max=255"`
+}
+
+type UpdateOrderRequest struct {
+	OriginAddress          *domain.Address     `json:"origin_address,omitempty" validate:"omitempty"`
+	OriginCoordinates      *domain.Coordinates `json:"origin_coordinates,omitempty" validate:"omitempty"`
+	DestinationAddress     *domain.Address     `json:"destination_address,omitempty" validate:"omitempty"`
+	DestinationCoordinates *domain.Coordinates `json:"destination_coordinates,omitempty" validate:"omitempty"`
+	SenderContact          *domain.Contact     `json:"sender_contact,omitempty" validate:"omitempty"`
+	RecipientContact       *domain.Contact     `json:"recipient_contact,omitempty" validate:"omitempty"`
+	TotalWeight            *float64            `json:"total_weight,omitempty" validate:"omitempty,min=0.1"`
+	Dimensions             *domain.Dimensions  `json:"dimensions,omitempty" validate:"omitempty"`
+}
+
+type CancelOrderRequest struct {
+	ReasonCode domain.CancellationReason `json:"reason_code" validate:"required,oneof=changed_mind duplicate_order wrong_address pickup_delayed other"`
+	Comment    string                    `json:"comment,omitempty" validate:"max=500"`
+}
+
+type DeleteOrderRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+type AttachQuoteRequest struct {
+	Price         float64 `json:"price" validate:"required,gt=0"`
+	Currency      string  `json:"currency" validate:"required,len=3,uppercase"`
+	HandlingNotes string  `json:"handling_notes,omitempty" validate:"max=1000"`
+}
+
+type RejectQuoteRequest struct {
+	Reason string `json:"reason,omitempty" validate:"max=500"`
+}
+
+type TimeWindowResponse struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	TimeZone string `json:"time_zone"`
 }
 
 type OrderResponse struct {
-	ID                     string             `json:"id"`
-	ClientID               string             `json:"client_id"`
-	OriginCoordinates      domain.Coordinates `json:"origin_coordinates"`
-	DestinationCoordinates domain.Coordinates `json:"destination_coordinates"`
-	OriginAddress          domain.Address     `json:"origin_address"`
-	DestinationAddress     domain.Address     `json:"destination_address"`
-	ProductQuantity        int                `json:"product_quantity"`
-	TotalWeight            float64            `json:"total_weight"`
-	PackageSize            domain.PackageSize `json:"package_size"`
-	Status                 domain.OrderStatus `json:"status"`
-	CreatedAt              string             `json:"created_at"`
-	UpdatedAt              string             `json:"updated_at"`
-	Client                 *UserResponse      `json:"client,omitempty"`
+	ID                     string                   `json:"id"`
+	ClientID               string                   `json:"client_id"`
+	TrackingCode           string                   `json:"tracking_code"`
+	OriginCoordinates      domain.Coordinates       `json:"origin_coordinates"`
+	DestinationCoordinates domain.Coordinates       `json:"destination_coordinates"`
+	OriginAddress          domain.Address           `json:"origin_address"`
+	DestinationAddress     domain.Address           `json:"destination_address"`
+	SenderContact          *domain.Contact          `json:"sender_contact,omitempty"`
+	RecipientContact       *domain.Contact          `json:"recipient_contact,omitempty"`
+	ProductQuantity        int                      `json:"product_quantity"`
+	TotalWeight            float64                  `json:"total_weight"`
+	Dimensions             *domain.Dimensions       `json:"dimensions,omitempty"`
+	VolumetricWeight       float64                  `json:"volumetric_weight"`
+	BillableWeight         float64                  `json:"billable_weight"`
+	PackageSize            domain.PackageSize       `json:"package_size"`
+	ServiceType            domain.ServiceType       `json:"service_type"`
+	Status                 domain.OrderStatus       `json:"status"`
+	DeliveryAttempts       int                      `json:"delivery_attempts"`
+	Price                  float64                  `json:"price,omitempty"`
+	Currency               string                   `json:"currency,omitempty"`
+	QuoteID                string                   `json:"quote_id,omitempty"`
+	HandlingNotes          string                   `json:"handling_notes,omitempty"`
+	CashOnDelivery         *CashOnDeliveryResponse  `json:"cash_on_delivery,omitempty"`
+	DeclaredValue          float64                  `json:"declared_value,omitempty"`
+	DeclaredValueCurrency  string                   `json:"declared_value_currency,omitempty"`
+	Insured                bool                     `json:"insured"`
+	InsuranceCoverage      float64                  `json:"insurance_coverage,omitempty"`
+	InsurancePremium       float64                  `json:"insurance_premium,omitempty"`
+	QuotedAt               string                   `json:"quoted_at,omitempty"`
+	QuoteAcceptedAt        string                   `json:"quote_accepted_at,omitempty"`
+	PickupWindow           *TimeWindowResponse      `json:"pickup_window,omitempty"`
+	DeliveryWindow         *TimeWindowResponse      `json:"delivery_window,omitempty"`
+	PromisedDeliveryAt     string                   `json:"promised_delivery_at,omitempty"`
+	ClosedAt               string                   `json:"closed_at,omitempty"`
+	Overdue                bool                     `json:"overdue"`
+	ReturnAddress          *domain.Address          `json:"return_address,omitempty"`
+	DistanceKm             float64                  `json:"distance_km,omitempty"`
+	Version                int                      `json:"version"`
+	CreatedAt              string                   `json:"created_at"`
+	UpdatedAt              string                   `json:"updated_at"`
+	DeletedAt              string                   `json:"deleted_at,omitempty"`
+	DeletedBy              string                   `json:"deleted_by,omitempty"`
+	DeletionReason         string                   `json:"deletion_reason,omitempty"`
+	Parcels                []*ParcelResponse        `json:"parcels,omitempty"`
+	ProofOfDelivery        *ProofOfDeliveryResponse `json:"proof_of_delivery,omitempty"`
+	Client                 *UserResponse            `json:"client,omitempty"`
+}
+
+type CashOnDeliveryResponse struct {
+	Amount          float64  `json:"amount"`
+	Currency        string   `json:"currency"`
+	CollectedAmount *float64 `json:"collected_amount,omitempty"`
+	CollectedAt     string   `json:"collected_at,omitempty"`
+	DriverID        string   `json:"driver_id,omitempty"`
+	Discrepancy     float64  `json:"discrepancy,omitempty"`
+}
+
+type ParcelResponse struct {
+	ID               string             `json:"id"`
+	Sequence         int                `json:"sequence"`
+	TrackingCode     string             `json:"tracking_code"`
+	Weight           float64            `json:"weight"`
+	Dimensions       *domain.Dimensions `json:"dimensions,omitempty"`
+	VolumetricWeight float64            `json:"volumetric_weight"`
+	BillableWeight   float64            `json:"billable_weight"`
+	Status           domain.OrderStatus `json:"status"`
+	UpdatedAt        string             `json:"updated_at"`
+}
+
+type OrderStatusEventResponse struct {
+	ID         string                  `json:"id"`
+	FromStatus domain.OrderStatus      `json:"from_status,omitempty"`
+	ToStatus   domain.OrderStatus      `json:"to_status"`
+	Action     domain.OrderEventAction `json:"action,omitempty"`
+	ActorID    string                  `json:"actor_id"`
+	Reason     string                  `json:"reason,omitempty"`
+	Location   string                  `json:"location,omitempty"`
+	CreatedAt  string                  `json:"created_at"`
+}
+
+type BatchStatusResult struct {
+	Reference string `json:"reference"`
+	OrderID   string `json:"order_id,omitempty"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+type BatchUpdateStatusResponse struct {
+	Status    domain.OrderStatus   `json:"status"`
+	Total     int                  `json:"total"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []*BatchStatusResult `json:"results"`
+}
+
+type OrderHistoryResponse struct {
+	OrderID       string                      `json:"order_id"`
+	CurrentStatus domain.OrderStatus          `json:"current_status"`
+	Events        []*OrderStatusEventResponse `json:"events"`
 }
 
 type ListOrdersRequest struct {
-	Page   int                `json:"page" validate:"min=1"`
-	Limit  int                `json:"limit" validate:"min=1,max=100"`
-	Status domain.OrderStatus `json:"status,omitempty"`
+	Page                 int                  `json:"page" validate:"min=1"`
+	Limit                int                  `json:"limit" validate:"min=1,max=100"`
+	Statuses             []domain.OrderStatus `json:"status,omitempty" validate:"omitempty,max=11,dive,oneof=creado recolectado en_estacion en_ruta entregado cancelado en_devolucion devuelto entregado_parcial pendiente_cotizacion cotizado"`
+	PackageSize          domain.PackageSize   `json:"package_size,omitempty" validate:"omitempty,oneof=S M L SPECIAL"`
+	ClientID             string               `json:"client_id,omitempty" validate:"omitempty,max=64"`
+	CreatedFrom          *time.Time           `json:"created_from,omitempty"`
+	CreatedTo            *time.Time           `json:"created_to,omitempty"`
+	PickupFrom           *time.Time           `json:"pickup_from,omitempty"`
+	PickupTo             *time.Time           `json:"pickup_to,omitempty"`
+	DeliveryFrom         *time.Time           `json:"delivery_from,omitempty"`
+	DeliveryTo           *time.Time           `json:"delivery_to,omitempty"`
+	OriginCity           string               `json:"origin_city,omitempty" validate:"max=100"`
+	DestinationCity      string               `json:"destination_city,omitempty" validate:"max=100"`
+	OriginZipPrefix      string               `json:"origin_zip_prefix,omitempty" validate:"max=20"`
+	DestinationZipPrefix string               `json:"destination_zip_prefix,omitempty" validate:"max=20"`
+	SortBy               string               `json:"sort_by,omitempty" validate:"omitempty,oneof=created_at updated_at total_weight"`
+	SortOrder            string               `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
+	Deleted              bool                 `json:"deleted,omitempty"`
+
+	// Pagination selects "offset" (page/limit) or "cursor" mode. Passing a
+	// Cursor implies cursor mode; IncludeTotal adds the count there.
+	Pagination   string `json:"pagination,omitempty" validate:"omitempty,oneof=offset cursor"`
+	Cursor       string `json:"cursor,omitempty" validate:"max=512"`
+	IncludeTotal bool   `json:"include_total,omitempty"`
 }
 
+func (r ListOrdersRequest) UsesCursor() bool {
+	return r.Pagination == "cursor" || r.Cursor != ""
+}
+
+// ListOrdersResponse leaves Page and TotalPages unset in cursor mode, and
+// Total too unless it was requested.
 type ListOrdersResponse struct {
 	Orders     []*OrderResponse `json:"orders"`
-	Total      int64            `json:"total"`
-	Page       int              `json:"page"`
+	Total      *int64           `json:"total,omitempty"`
+	Page       int              `json:"page,omitempty"`
 	Limit      int              `json:"limit"`
-	TotalPages int              `json:"total_pages"`
+	TotalPages *int             `json:"total_pages,omitempty"`
+	NextCursor string           `json:"next_cursor,omitempty"`
 }
 
 func ToOrderResponse(order *domain.Order) *OrderResponse {
 	response := &OrderResponse{
 		ID:                     order.ID,
 		ClientID:               order.ClientID,
+		TrackingCode:           order.TrackingCode,
 		OriginCoordinates:      order.OriginCoords,
 		DestinationCoordinates: order.DestinationCoords,
 		OriginAddress:          order.OriginAddress,
 		DestinationAddress:     order.DestinationAddress,
 		ProductQuantity:        order.ProductQuantity,
 		TotalWeight:            order.TotalWeight,
+		Dimensions:             order.Dimensions,
+		VolumetricWeight:       order.VolumetricWeight,
+		BillableWeight:         order.BillableWeight,
 		PackageSize:            order.PackageSize,
+		ServiceType:            order.ServiceType,
 		Status:                 order.Status,
+		DeliveryAttempts:       order.DeliveryAttempts,
+		Price:                  order.Price,
+		Currency:               order.Currency,
+		QuoteID:                order.QuoteID,
+		HandlingNotes:          order.HandlingNotes,
+		DeclaredValue:          order.DeclaredValue,
+		DeclaredValueCurrency:  order.DeclaredValueCurrency,
+		Insured:                order.Insured,
+		InsuranceCoverage:      order.InsuranceCoverage,
+		InsurancePremium:       order.InsurancePremium,
+		Version:                order.Version,
+		Overdue:                order.IsOverdue(time.Now()),
 		CreatedAt:              order.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:              order.UpdatedAt.Format(time.RFC3339),
 	}
 
+	if order.QuotedAt != nil {
+		response.QuotedAt = order.QuotedAt.Format(time.RFC3339)
+	}
+
+	if order.QuoteAcceptedAt != nil {
+		response.QuoteAcceptedAt = order.QuoteAcceptedAt.Format(time.RFC3339)
+	}
+
+	if order.PickupWindow.IsSet() {
+		response.PickupWindow = ToTimeWindowResponse(order.PickupWindow)
+	}
+
+	if order.DeliveryWindow.IsSet() {
+		response.DeliveryWindow = ToTimeWindowResponse(order.DeliveryWindow)
+	}
+
+	if order.PromisedDeliveryAt != nil {
+		response.PromisedDeliveryAt = order.PromisedDeliveryAt.Format(time.RFC3339)
+	}
+
+	if order.ClosedAt != nil {
+		response.ClosedAt = order.ClosedAt.Format(time.RFC3339)
+	}
+
+	if order.RequiresCashCollection() {
+		response.CashOnDelivery = ToCashOnDeliveryResponse(order)
+	}
+
+	if order.IsDeleted() {
+		response.DeletedAt = order.DeletedAt.Format(time.RFC3339)
+		response.DeletedBy = order.DeletedBy
+		response.DeletionReason = order.DeletionReason
+	}
+
+	if order.IsReturningToSender() {
+		returnAddress := order.ReturnAddress()
+		response.ReturnAddress = &returnAddress
+	}
+
+	if len(order.Parcels) > 0 {
+		response.Parcels = make([]*ParcelResponse, len(order.Parcels))
+		for i := range order.Parcels {
+			response.Parcels[i] = ToParcelResponse(&order.Parcels[i])
+		}
+	}
+
+	if order.ProofOfDelivery != nil {
+		response.ProofOfDelivery = ToProofOfDeliveryResponse(order.ProofOfDelivery)
+	}
+
 	if order.Client.ID != "" {
 		response.Client = ToUserResponse(&order.Client)
 	}
 
+	if order.SenderContact.IsSet() {
+		response.SenderContact = order.SenderContact
+	}
+	if order.RecipientContact.IsSet() {
+		response.RecipientContact = order.RecipientContact
+	}
+
 	return response
 }
 
+func ToCashOnDeliveryResponse(order *domain.Order) *CashOnDeliveryResponse {
+	response := &CashOnDeliveryResponse{
+		Amount:          order.CODAmount,
+		Currency:        order.CODCurrency,
+		CollectedAmount: order.CODCollectedAmount,
+		DriverID:        order.CODDriverID,
+		Discrepancy:     order.CashDiscrepancy(),
+	}
+
+	if order.CODCollectedAt != nil {
+		response.CollectedAt = order.CODCollectedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+// ToTimeWindow parses a requested window; a nil request yields a nil window.
+func ToTimeWindow(req *TimeWindowRequest) (*domain.TimeWindow, error) {
+	if req == nil {
+		return nil, nil
+	}
+	return domain.ParseTimeWindow(req.Start, req.End, req.TimeZone)
+}
+
+// ToTimeWindowResponse renders the window in its own time zone.
+func ToTimeWindowResponse(window *domain.TimeWindow) *TimeWindowResponse {
+	location := window.Location()
+	return &TimeWindowResponse{
+		Start:    window.Start.In(location).Format(time.RFC3339),
+		End:      window.End.In(location).Format(time.RFC3339),
+		TimeZone: window.TimeZone,
+	}
+}
+
+func ToOrderEdit(req UpdateOrderRequest) domain.OrderEdit {
+	return domain.OrderEdit{
+		OriginAddress:      req.OriginAddress,
+		OriginCoords:       req.OriginCoordinates,
+		DestinationAddress: req.DestinationAddress,
+		DestinationCoords:  req.DestinationCoordinates,
+		SenderContact:      req.SenderContact,
+		RecipientContact:   req.RecipientContact,
+		TotalWeight:        req.TotalWeight,
+		Dimensions:         req.Dimensions,
+	}
+}
+
+func ToParcelResponse(parcel *domain.Parcel) *ParcelResponse {
+	return &ParcelResponse{
+		ID:               parcel.ID,
+		Sequence:         parcel.Sequence,
+		TrackingCode:     parcel.TrackingCode,
+		Weight:           parcel.Weight,
+		Dimensions:       parcel.Dimensions,
+		VolumetricWeight: parcel.VolumetricWeight,
+		BillableWeight:   parcel.BillableWeight,
+		Status:           parcel.Status,
+		UpdatedAt:        parcel.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
 func ToOrderResponseList(orders []*domain.Order) []*OrderResponse {
 	responses := make([]*OrderResponse, len(orders))
 	for i, order := range orders {
@@ -78,3 +404,29 @@ func ToOrderResponseList(orders []*domain.Order) []*OrderResponse {
 	}
 	return responses
 }
+
+func ToOrderStatusEventResponse(event *domain.OrderStatusEvent) *OrderStatusEventResponse {
+	return &OrderStatusEventResponse{
+		ID:         event.ID,
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		Action:     event.Action,
+		ActorID:    event.ActorID,
+		Reason:     event.Reason,
+		Location:   event.Location,
+		CreatedAt:  event.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToOrderHistoryResponse(order *domain.Order, events []*domain.OrderStatusEvent) *OrderHistoryResponse {
+	responses := make([]*OrderStatusEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = ToOrderStatusEventResponse(event)
+	}
+
+	return &OrderHistoryResponse{
+		OrderID:       order.ID,
+		CurrentStatus: order.Status,
+		Events:        responses,
+	}
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+type QuoteRequest struct {
+	OriginCoordinates      domain.Coordinates `json:"origin_coordinates" validate:"required"`
+	DestinationCoordinates domain.Coordinates `json:"destination_coordinates" validate:"required"`
+	PackageSize            domain.PackageSize `json:"package_size" validate:"required,oneof=S M L"`
+	ServiceType            domain.ServiceType `json:"service_type" validate:"omitempty,oneof=standard express"`
+	DeclaredValue          *float64           `json:"declared_value,omitempty" validate:"omitempty,gt=0,max=10000000"`
+	Insured                bool               `json:"insured,omitempty"`
+}
+
+type PriceLineItemResponse struct {
+	Code        string  `json:"code"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+type QuoteResponse struct {
+	QuoteID     string                   `json:"quote_id"`
+	ExpiresAt   time.Time                `json:"expires_at"`
+	ServiceType domain.ServiceType       `json:"service_type"`
+	PackageSize domain.PackageSize       `json:"package_size"`
+	DistanceKm  float64                  `json:"distance_km"`
+	Currency    string                   `json:"currency"`
+	Items       []*PriceLineItemResponse `json:"items"`
+	Total       float64                  `json:"total"`
+}
+
+// ToQuoteResponse shows the priced quote, which may include insurance that
+// the stored one leaves out.
+func ToQuoteResponse(stored *domain.ShipmentQuote, quote *domain.PriceQuote) *QuoteResponse {
+	items := make([]*PriceLineItemResponse, len(quote.Items))
+	for i, item := range quote.Items {
+		items[i] = &PriceLineItemResponse{
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      item.Amount,
+		}
+	}
+
+	return &QuoteResponse{
+		QuoteID:     stored.ID,
+		ExpiresAt:   stored.ExpiresAt,
+		ServiceType: quote.ServiceType,
+		PackageSize: quote.PackageSize,
+		DistanceKm:  quote.DistanceKm,
+		Currency:    quote.Currency,
+		Items:       items,
+		Total:       quote.Total,
+	}
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+type DeliverOrderRequest struct {
+	RecipientName string                      `json:"recipient_name" form:"recipient_name" validate:"required,max=255"`
+	Relationship  domain.DeliveryRelationship `json:"relationship" form:"relationship" validate:"required,oneof=recipient family neighbor reception other"`
+	Latitude      *float64                    `json:"latitude" form:"latitude" validate:"required,min=-90,max=90"`
+	Longitude     *float64                    `json:"longitude" form:"longitude" validate:"required,min=-180,max=180"`
+	Location      string                      `json:"location,omitempty" form:"location" validate:"max=255"`
+
+	// CollectedAmount is required for cash-on-delivery orders. DriverID
+	// defaults to the user confirming the delivery.
+	CollectedAmount *float64 `json:"collected_amount,omitempty" form:"collected_amount" validate:"omitempty,min=0,max=1000000"`
+	DriverID        string   `json:"driver_id,omitempty" form:"driver_id" validate:"max=64"`
+}
+
+// ProofFile is an uploaded signature or photo.
+type ProofFile struct {
+	Content io.Reader
+	Size    int64
+}
+
+type ProofOfDeliveryResponse struct {
+	RecipientName string                      `json:"recipient_name"`
+	Relationship  domain.DeliveryRelationship `json:"relationship"`
+	Location      domain.Coordinates          `json:"location"`
+	SignatureURL  string                      `json:"signature_url,omitempty"`
+	PhotoURL      string                      `json:"photo_url,omitempty"`
+	ActorID       string                      `json:"actor_id"`
+	DeliveredAt   string                      `json:"delivered_at"`
+}
+
+func ToProofOfDeliveryResponse(proof *domain.ProofOfDelivery) *ProofOfDeliveryResponse {
+	response := &ProofOfDeliveryResponse{
+		RecipientName: proof.RecipientName,
+		Relationship:  proof.Relationship,
+		Location:      proof.Location,
+		ActorID:       proof.ActorID,
+		DeliveredAt:   proof.DeliveredAt.Format(time.RFC3339),
+	}
+
+	if proof.SignatureKey != "" {
+		response.SignatureURL = proofFileURL(proof.OrderID, domain.ProofFileSignature)
+	}
+
+	if proof.PhotoKey != "" {
+		response.PhotoURL = proofFileURL(proof.OrderID, domain.ProofFilePhoto)
+	}
+
+	return response
+}
+
+func proofFileURL(orderID string, kind domain.ProofFileKind) string {
+	return fmt.Sprintf("/api/v1/orders/%s/proof-of-delivery/%s", orderID, kind)
+}
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"logistics-api/internal/core/domain"
+	"time"
+)
+
+// TrackingResponse is the public view of an order. It leaves out anything
+// that identifies the client or the exact addresses, and contacts are
+// redacted.
+type TrackingResponse struct {
+	TrackingCode       string                    `json:"tracking_code"`
+	Status             domain.OrderStatus        `json:"status"`
+	ServiceType        domain.ServiceType        `json:"service_type"`
+	Origin             TrackingLocation          `json:"origin"`
+	Destination        TrackingLocation          `json:"destination"`
+	Sender             *TrackingContact          `json:"sender,omitempty"`
+	Recipient          *TrackingContact          `json:"recipient,omitempty"`
+	PromisedDeliveryAt string                    `json:"promised_delivery_at,omitempty"`
+	DeliveredAt        string                    `json:"delivered_at,omitempty"`
+	Parcels            []*TrackingParcelResponse `json:"parcels,omitempty"`
+	Timeline           []*TrackingEventResponse  `json:"timeline"`
+}
+
+type TrackingLocation struct {
+	City    string `json:"city"`
+	State   string `json:"state"`
+	Country string `json:"country"`
+}
+
+type TrackingContact struct {
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+}
+
+type TrackingParcelResponse struct {
+	TrackingCode string             `json:"tracking_code"`
+	Status       domain.OrderStatus `json:"status"`
+}
+
+type TrackingEventResponse struct {
+	Status     domain.OrderStatus `json:"status"`
+	OccurredAt string             `json:"occurred_at"`
+}
+
+func toTrackingLocation(address domain.Address) TrackingLocation {
+	return TrackingLocation{
+		City:    address.City,
+		State:   address.State,
+		Country: address.Country,
+	}
+}
+
+func toTrackingContact(contact *domain.Contact) *TrackingContact {
+	if !contact.IsSet() {
+		return nil
+	}
+	redacted := contact.Redacted()
+	return &TrackingContact{
+		Name:  redacted.Name,
+		Phone: redacted.Phone,
+	}
+}
+
+func ToTrackingResponse(order *domain.Order, events []*domain.OrderStatusEvent) *TrackingResponse {
+	response := &TrackingResponse{
+		TrackingCode: order.TrackingCode,
+		Status:       order.Status,
+		ServiceType:  order.ServiceType,
+		Origin:       toTrackingLocation(order.OriginAddress),
+		Destination:  toTrackingLocation(order.DestinationAddress),
+		Sender:       toTrackingContact(order.SenderContact),
+		Recipient:    toTrackingContact(order.RecipientContact),
+		Timeline:     make([]*TrackingEventResponse, 0, len(events)),
+	}
+
+	if order.PromisedDeliveryAt != nil {
+		response.PromisedDeliveryAt = order.PromisedDeliveryAt.Format(time.RFC3339)
+	}
+
+	if order.ProofOfDelivery != nil {
+		response.DeliveredAt = order.ProofOfDelivery.DeliveredAt.Format(time.RFC3339)
+	}
+
+	if len(order.Parcels) > 1 {
+		response.Parcels = make([]*TrackingParcelResponse, len(order.Parcels))
+		for i, parcel := range order.Parcels {
+			response.Parcels[i] = &TrackingParcelResponse{
+				TrackingCode: parcel.TrackingCode,
+				Status:       parcel.Status,
+			}
+		}
+	}
+
+	// Reasons, actors and checkpoint locations are typed freely by staff and
+	// may name people or addresses, so only the status and time are shared.
+	// Deletions, restores and edits are left out entirely.
+	for _, event := range events {
+		if event.Action != "" {
+			continue
+		}
+		response.Timeline = append(response.Timeline, &TrackingEventResponse{
+			Status:     event.ToStatus,
+			OccurredAt: event.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,76 @@
+package dto
+
+import "logistics-api/internal/core/domain"
+
+type WorkflowTransitionRequest struct {
+	From   domain.OrderStatus `json:"from" validate:"required"`
+	To     domain.OrderStatus `json:"to" validate:"required"`
+	Roles  []domain.UserRole  `json:"roles" validate:"required,min=1,dive,oneof=client admin"`
+	Guards []string           `json:"guards,omitempty"`
+}
+
+type SaveWorkflowRequest struct {
+	Name        string                      `json:"name" validate:"required,max=100"`
+	States      []domain.OrderStatus        `json:"states" validate:"required,min=1"`
+	Transitions []WorkflowTransitionRequest `json:"transitions" validate:"required,dive"`
+}
+
+type WorkflowTransitionResponse struct {
+	From   domain.OrderStatus `json:"from"`
+	To     domain.OrderStatus `json:"to"`
+	Roles  []domain.UserRole  `json:"roles"`
+	Guards []string           `json:"guards,omitempty"`
+}
+
+type WorkflowResponse struct {
+	Name        string                        `json:"name"`
+	ServiceType domain.ServiceType            `json:"service_type"`
+	States      []domain.OrderStatus          `json:"states"`
+	Transitions []*WorkflowTransitionResponse `json:"transitions"`
+}
+
+func ToWorkflow(serviceType domain.ServiceType, req SaveWorkflowRequest) *domain.Workflow {
+	transitions := make([]domain.WorkflowTransition, len(req.Transitions))
+	for i, t := range req.Transitions {
+		transitions[i] = domain.WorkflowTransition{
+			From:   t.From,
+			To:     t.To,
+			Roles:  t.Roles,
+			Guards: t.Guards,
+		}
+	}
+
+	return &domain.Workflow{
+		Name:        req.Name,
+		ServiceType: serviceType,
+		States:      req.States,
+		Transitions: transitions,
+	}
+}
+
+func ToWorkflowResponse(workflow *domain.Workflow) *WorkflowResponse {
+	transitions := make([]*WorkflowTransitionResponse, len(workflow.Transitions))
+	for i, t := range workflow.Transitions {
+		transitions[i] = &WorkflowTransitionResponse{
+			From:   t.From,
+			To:     t.To,
+			Roles:  t.Roles,
+			Guards: t.Guards,
+		}
+	}
+
+	return &WorkflowResponse{
+		Name:        workflow.Name,
+		ServiceType: workflow.ServiceType,
+		States:      workflow.States,
+		Transitions: transitions,
+	}
+}
+
+func ToWorkflowResponseList(workflows []*domain.Workflow) []*WorkflowResponse {
+	responses := make([]*WorkflowResponse, len(workflows))
+	for i, workflow := range workflows {
+		responses[i] = ToWorkflowResponse(workflow)
+	}
+	return responses
+}
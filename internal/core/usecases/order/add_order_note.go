@@ -0,0 +1,70 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type AddOrderNoteUseCase struct {
+	orderRepo repositories.OrderRepository
+	noteRepo  repositories.OrderNoteRepository
+	logger    logger.Logger
+}
+
+func NewAddOrderNoteUseCase(
+	orderRepo repositories.OrderRepository,
+	noteRepo repositories.OrderNoteRepository,
+	logger logger.Logger,
+) *AddOrderNoteUseCase {
+	return &AddOrderNoteUseCase{
+		orderRepo: orderRepo,
+		noteRepo:  noteRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *AddOrderNoteUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole, req dto.AddOrderNoteRequest) (*dto.OrderNoteResponse, error) {
+	uc.logger.Info("Adding order note",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+		logger.String("visibility", string(req.Visibility)),
+	)
+
+	if _, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole); err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	note, err := domain.NewOrderNote(orderID, userID, userRole, req.Visibility, req.Body)
+	if err != nil {
+		if errors.Is(err, domain.ErrInternalNoteNotPermitted) {
+			uc.logger.Warn("Client attempted to write an internal note",
+				logger.String("order_id", orderID),
+				logger.String("user_id", userID),
+			)
+			return nil, appErrors.NewForbiddenError()
+		}
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if err := uc.noteRepo.Create(ctx, note); err != nil {
+		uc.logger.Error("Failed to save order note", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	uc.logger.Info("Order note added successfully",
+		logger.String("order_id", orderID),
+		logger.String("note_id", note.ID),
+	)
+
+	return dto.ToOrderNoteResponse(note), nil
+}
@@ -0,0 +1,95 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type AttachQuoteUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	workflows services.WorkflowProvider
+	logger    logger.Logger
+}
+
+func NewAttachQuoteUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	workflows services.WorkflowProvider,
+	logger logger.Logger,
+) *AttachQuoteUseCase {
+	return &AttachQuoteUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		workflows: workflows,
+		logger:    logger,
+	}
+}
+
+func (uc *AttachQuoteUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole, req dto.AttachQuoteRequest) (*dto.OrderResponse, error) {
+	uc.logger.Info("Attaching quote to order",
+		logger.String("order_id", orderID),
+		logger.Float64("price", req.Price),
+		logger.String("currency", req.Currency),
+	)
+
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Order not found", logger.String("order_id", orderID))
+		return nil, appErrors.NewNotFoundError("order")
+	}
+
+	workflow, err := uc.workflows.GetWorkflow(ctx, order.ServiceType)
+	if err != nil {
+		uc.logger.Error("Failed to resolve workflow",
+			logger.String("service_type", string(order.ServiceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	previousStatus := order.Status
+	if err := order.AttachQuote(workflow, userRole, req.Price, req.Currency, req.HandlingNotes); err != nil {
+		if errors.Is(err, domain.ErrTransitionNotPermitted) {
+			return nil, appErrors.NewForbiddenError()
+		}
+
+		uc.logger.Warn("Quote rejected",
+			logger.String("order_id", orderID),
+			logger.String("current_status", string(order.Status)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	event, err := domain.NewOrderStatusEvent(order.ID, previousStatus, order.Status, userID, "", "")
+	if err != nil {
+		uc.logger.Error("Failed to create status event", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to save quote", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Quote attached successfully", logger.String("order_id", orderID))
+
+	return dto.ToOrderResponse(order), nil
+}
@@ -0,0 +1,103 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type BatchUpdateStatusUseCase struct {
+	orderRepo    repositories.OrderRepository
+	updateStatus *UpdateOrderStatusUseCase
+	logger       logger.Logger
+}
+
+func NewBatchUpdateStatusUseCase(
+	orderRepo repositories.OrderRepository,
+	updateStatus *UpdateOrderStatusUseCase,
+	logger logger.Logger,
+) *BatchUpdateStatusUseCase {
+	return &BatchUpdateStatusUseCase{
+		orderRepo:    orderRepo,
+		updateStatus: updateStatus,
+		logger:       logger,
+	}
+}
+
+// Execute moves each referenced order to the target status on its own, so
+// one failure doesn't block the rest of the batch.
+func (uc *BatchUpdateStatusUseCase) Execute(ctx context.Context, userID string, userRole domain.UserRole, req dto.BatchUpdateStatusRequest) *dto.BatchUpdateStatusResponse {
+	uc.logger.Info("Updating order status in batch",
+		logger.String("new_status", string(req.Status)),
+		logger.Int("orders", len(req.References)),
+	)
+
+	response := &dto.BatchUpdateStatusResponse{
+		Status:  req.Status,
+		Total:   len(req.References),
+		Results: make([]*dto.BatchStatusResult, len(req.References)),
+	}
+
+	seen := make(map[string]bool, len(req.References))
+	statusReq := dto.UpdateOrderStatusRequest{Status: req.Status, Reason: req.Reason, Location: req.Location}
+	for i, reference := range req.References {
+		result := &dto.BatchStatusResult{Reference: reference}
+		response.Results[i] = result
+
+		orderID, err := uc.resolveOrderID(ctx, reference)
+		if err != nil {
+			result.Error = err.Error()
+			response.Failed++
+			continue
+		}
+		result.OrderID = orderID
+
+		if seen[orderID] {
+			result.Error = "order already included in this batch"
+			response.Failed++
+			continue
+		}
+		seen[orderID] = true
+
+		if _, err := uc.updateStatus.Execute(ctx, orderID, userID, userRole, statusReq); err != nil {
+			var appErr *appErrors.AppError
+			if errors.As(err, &appErr) {
+				result.Error = appErr.Message
+			} else {
+				result.Error = "failed to update order"
+			}
+			response.Failed++
+			continue
+		}
+
+		result.Success = true
+		response.Succeeded++
+	}
+
+	uc.logger.Info("Batch status update completed",
+		logger.String("new_status", string(req.Status)),
+		logger.Int("succeeded", response.Succeeded),
+		logger.Int("failed", response.Failed),
+	)
+
+	return response
+}
+
+// resolveOrderID accepts either an order ID or a public tracking code.
+func (uc *BatchUpdateStatusUseCase) resolveOrderID(ctx context.Context, reference string) (string, error) {
+	code := domain.NormalizeTrackingCode(reference)
+	if !domain.IsValidTrackingCode(code) {
+		return reference, nil
+	}
+
+	order, err := uc.orderRepo.GetByTrackingCode(ctx, code)
+	if err != nil {
+		return "", errors.New("order not found")
+	}
+	return order.ID, nil
+}
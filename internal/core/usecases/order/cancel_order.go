@@ -0,0 +1,105 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type CancelOrderUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	workflows services.WorkflowProvider
+	cutoff    time.Duration
+	logger    logger.Logger
+}
+
+func NewCancelOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	workflows services.WorkflowProvider,
+	cutoff time.Duration,
+	logger logger.Logger,
+) *CancelOrderUseCase {
+	return &CancelOrderUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		workflows: workflows,
+		cutoff:    cutoff,
+		logger:    logger,
+	}
+}
+
+func (uc *CancelOrderUseCase) Execute(ctx context.Context, orderID, clientID string, req dto.CancelOrderRequest) (*dto.OrderResponse, error) {
+	uc.logger.Info("Cancelling order",
+		logger.String("order_id", orderID),
+		logger.String("client_id", clientID),
+		logger.String("reason_code", string(req.ReasonCode)),
+	)
+
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, clientID, domain.ClientRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to client",
+			logger.String("order_id", orderID),
+			logger.String("client_id", clientID),
+		)
+		return nil, err
+	}
+
+	workflow, err := uc.workflows.GetWorkflow(ctx, order.ServiceType)
+	if err != nil {
+		uc.logger.Error("Failed to resolve workflow",
+			logger.String("service_type", string(order.ServiceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	previousStatus := order.Status
+	if err := order.CancelByClient(workflow, time.Now(), uc.cutoff); err != nil {
+		uc.logger.Warn("Order cannot be cancelled",
+			logger.String("order_id", orderID),
+			logger.String("current_status", string(order.Status)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	reason := string(req.ReasonCode)
+	if req.Comment != "" {
+		reason += ": " + req.Comment
+	}
+
+	event, err := domain.NewOrderStatusEvent(order.ID, previousStatus, order.Status, clientID, reason, "")
+	if err != nil {
+		uc.logger.Error("Failed to create status event", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to cancel order", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Order cancelled successfully",
+		logger.String("order_id", orderID),
+		logger.String("client_id", clientID),
+	)
+
+	return dto.ToOrderResponse(order), nil
+}
@@ -0,0 +1,167 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+func TestCancelOrderCutoff(t *testing.T) {
+	tests := []struct {
+		name       string
+		pickupIn   time.Duration
+		wantStatus domain.OrderStatus
+		wantCode   int
+	}{
+		{name: "well before pickup", pickupIn: 3 * time.Hour, wantStatus: domain.StatusCancelled},
+		{name: "inside the cutoff", pickupIn: 30 * time.Minute, wantStatus: domain.StatusCreated, wantCode: http.StatusBadRequest},
+		{name: "pickup already started", pickupIn: -time.Hour, wantStatus: domain.StatusCreated, wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pickupAt := time.Now().Add(tt.pickupIn)
+			order := &domain.Order{
+				ID:           "order-1",
+				ClientID:     "client-1",
+				Status:       domain.StatusCreated,
+				ServiceType:  domain.ServiceTypeStandard,
+				PickupWindow: &domain.TimeWindow{Start: pickupAt, End: pickupAt.Add(2 * time.Hour), TimeZone: "UTC"},
+			}
+			orders := newFakeOrderRepo(order)
+			events := &fakeEventRepo{}
+			uc := NewCancelOrderUseCase(orders, events, fakeTxManager{}, defaultWorkflows{}, time.Hour, nopLogger{})
+
+			req := dto.CancelOrderRequest{ReasonCode: domain.CancellationReasonChangedMind, Comment: "no longer needed"}
+			_, err := uc.Execute(context.Background(), "order-1", "client-1", req)
+
+			if code := appErrorCode(err); code != tt.wantCode {
+				t.Fatalf("Execute() error = %v, want code %d", err, tt.wantCode)
+			}
+			if orders.orders["order-1"].Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", orders.orders["order-1"].Status, tt.wantStatus)
+			}
+
+			if tt.wantCode != 0 {
+				if orders.updates != 0 || len(events.events) != 0 {
+					t.Errorf("rejected cancellation wrote %d updates and %d events", orders.updates, len(events.events))
+				}
+				return
+			}
+			if len(events.events) != 1 || events.events[0].Reason != "changed_mind: no longer needed" {
+				t.Errorf("events = %+v, want one with the reason and comment", events.events)
+			}
+		})
+	}
+}
+
+func TestCancelOrderOfAnotherClient(t *testing.T) {
+	orders := newFakeOrderRepo(&domain.Order{
+		ID:          "order-1",
+		ClientID:    "client-2",
+		Status:      domain.StatusCreated,
+		ServiceType: domain.ServiceTypeStandard,
+	})
+	uc := NewCancelOrderUseCase(orders, &fakeEventRepo{}, fakeTxManager{}, defaultWorkflows{}, time.Hour, nopLogger{})
+
+	_, err := uc.Execute(context.Background(), "order-1", "client-1", dto.CancelOrderRequest{ReasonCode: domain.CancellationReasonOther})
+	if code := appErrorCode(err); code != http.StatusNotFound {
+		t.Errorf("Execute() error = %v, want code %d", err, http.StatusNotFound)
+	}
+}
+
+func appErrorCode(err error) int {
+	var appErr *appErrors.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code
+	}
+	if err != nil {
+		return -1
+	}
+	return 0
+}
+
+// fakeOrderRepo implements the lookups and writes the use cases under test
+// need; any other method panics through the nil embedded interface.
+type fakeOrderRepo struct {
+	repositories.OrderRepository
+	orders  map[string]*domain.Order
+	updates int
+}
+
+func newFakeOrderRepo(orders ...*domain.Order) *fakeOrderRepo {
+	repo := &fakeOrderRepo{orders: make(map[string]*domain.Order)}
+	for _, order := range orders {
+		repo.orders[order.ID] = order
+	}
+	return repo
+}
+
+func (r *fakeOrderRepo) GetByID(ctx context.Context, id string) (*domain.Order, error) {
+	order, ok := r.orders[id]
+	if !ok {
+		return nil, errors.New("order not found")
+	}
+	return order, nil
+}
+
+func (r *fakeOrderRepo) Update(ctx context.Context, order *domain.Order) error {
+	r.updates++
+	r.orders[order.ID] = order
+	return nil
+}
+
+type fakeEventRepo struct {
+	events []*domain.OrderStatusEvent
+}
+
+func (r *fakeEventRepo) Create(ctx context.Context, event *domain.OrderStatusEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *fakeEventRepo) GetByOrderID(ctx context.Context, orderID string) ([]*domain.OrderStatusEvent, error) {
+	return r.events, nil
+}
+
+type fakeTxManager struct{}
+
+func (fakeTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type defaultWorkflows struct{}
+
+func (defaultWorkflows) GetWorkflow(ctx context.Context, serviceType domain.ServiceType) (*domain.Workflow, error) {
+	for _, wf := range domain.DefaultWorkflows() {
+		if wf.ServiceType == serviceType {
+			return wf, nil
+		}
+	}
+	return nil, errors.New("no workflow")
+}
+
+func (defaultWorkflows) GetWorkflows(ctx context.Context) ([]*domain.Workflow, error) {
+	return domain.DefaultWorkflows(), nil
+}
+
+func (defaultWorkflows) SaveWorkflow(ctx context.Context, workflow *domain.Workflow) error {
+	return errors.New("read only")
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...logger.Field)        {}
+func (nopLogger) Info(string, ...logger.Field)         {}
+func (nopLogger) Warn(string, ...logger.Field)         {}
+func (nopLogger) Error(string, ...logger.Field)        {}
+func (nopLogger) Fatal(string, ...logger.Field)        {}
+func (l nopLogger) With(...logger.Field) logger.Logger { return l }
@@ -2,6 +2,11 @@ package order
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
 	"logistics-api/internal/core/domain"
 	"logistics-api/internal/core/ports/repositories"
 	"logistics-api/internal/core/ports/services"
@@ -11,23 +16,44 @@ import (
 )
 
 type CreateOrderUseCase struct {
-	orderRepo    repositories.OrderRepository
-	userRepo     repositories.UserRepository
-	coordService services.CoordinateService
-	logger       logger.Logger
+	orderRepo         repositories.OrderRepository
+	userRepo          repositories.UserRepository
+	eventRepo         repositories.OrderStatusEventRepository
+	quoteRepo         repositories.ShipmentQuoteRepository
+	txManager         repositories.TransactionManager
+	coordService      services.CoordinateService
+	pricing           services.PricingService
+	sla               services.SLACalculator
+	volumetricDivisor float64
+	insurance         domain.InsurancePolicy
+	logger            logger.Logger
 }
 
 func NewCreateOrderUseCase(
 	orderRepo repositories.OrderRepository,
 	userRepo repositories.UserRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	quoteRepo repositories.ShipmentQuoteRepository,
+	txManager repositories.TransactionManager,
 	coordService services.CoordinateService,
+	pricing services.PricingService,
+	sla services.SLACalculator,
+	volumetricDivisor float64,
+	insurance domain.InsurancePolicy,
 	logger logger.Logger,
 ) *CreateOrderUseCase {
 	return &CreateOrderUseCase{
-		orderRepo:    orderRepo,
-		userRepo:     userRepo,
-		coordService: coordService,
-		logger:       logger,
+		orderRepo:         orderRepo,
+		userRepo:          userRepo,
+		eventRepo:         eventRepo,
+		quoteRepo:         quoteRepo,
+		txManager:         txManager,
+		coordService:      coordService,
+		pricing:           pricing,
+		sla:               sla,
+		volumetricDivisor: volumetricDivisor,
+		insurance:         insurance,
+		logger:            logger,
 	}
 }
 
@@ -50,6 +76,12 @@ func (uc *CreateOrderUseCase) Execute(ctx context.Context, clientID string, req
 		return nil, appErrors.NewValidationError("invalid destination coordinates")
 	}
 
+	parcelSpecs, err := buildParcelSpecs(req)
+	if err != nil {
+		uc.logger.Warn("Invalid parcels", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
 	order, err := domain.NewOrder(
 		clientID,
 		req.OriginCoordinates,
@@ -57,14 +89,78 @@ func (uc *CreateOrderUseCase) Execute(ctx context.Context, clientID string, req
 		req.OriginAddress,
 		req.DestinationAddress,
 		req.ProductQuantity,
-		req.TotalWeight,
+		parcelSpecs,
+		uc.volumetricDivisor,
+		req.ServiceType,
 	)
 	if err != nil {
 		uc.logger.Error("Failed to create order entity", logger.Error(err))
+		if errors.Is(err, domain.ErrTrackingCodeUnavailable) {
+			return nil, appErrors.NewInternalError()
+		}
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if err := order.SetContacts(req.SenderContact, req.RecipientContact); err != nil {
+		uc.logger.Warn("Invalid contacts", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if err := uc.scheduleWindows(order, req); err != nil {
+		uc.logger.Warn("Invalid time windows", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if req.CODAmount != nil {
+		if err := order.SetCashOnDelivery(*req.CODAmount, req.CODCurrency); err != nil {
+			uc.logger.Warn("Invalid cash on delivery", logger.Error(err))
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+	} else if req.CODCurrency != "" {
+		return nil, appErrors.NewValidationError("cod_currency requires cod_amount")
+	}
+
+	if err := uc.declareValue(order, req); err != nil {
+		uc.logger.Warn("Invalid declared value", logger.Error(err))
 		return nil, appErrors.NewValidationError(err.Error())
 	}
 
-	if err := uc.orderRepo.Create(ctx, order); err != nil {
+	if req.QuoteID != "" && order.PackageSize == domain.PackageSizeSpecial {
+		return nil, appErrors.NewValidationError("special packages are priced manually and cannot use a quote_id")
+	}
+
+	// SPECIAL packages stay unpriced and unpromised until their quote is accepted.
+	if order.PackageSize != domain.PackageSizeSpecial {
+		quote, err := uc.price(ctx, order, clientID, req.QuoteID)
+		if err != nil {
+			return nil, err
+		}
+		if err := order.ApplyQuote(quote); err != nil {
+			uc.logger.Warn("Declared value does not match the price currency", logger.Error(err))
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+
+		promisedAt, err := uc.sla.PromisedDeliveryAt(ctx, order)
+		if err != nil {
+			uc.logger.Error("Failed to compute delivery promise", logger.Error(err))
+			return nil, appErrors.NewInternalError()
+		}
+		order.PromisedDeliveryAt = &promisedAt
+	}
+
+	event, err := domain.NewOrderStatusEvent(order.ID, "", order.Status, clientID, "", "")
+	if err != nil {
+		uc.logger.Error("Failed to create status event", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Create(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
 		uc.logger.Error("Failed to save order", logger.Error(err))
 		return nil, appErrors.NewInternalError()
 	}
@@ -78,3 +174,90 @@ func (uc *CreateOrderUseCase) Execute(ctx context.Context, clientID string, req
 
 	return dto.ToOrderResponse(order), nil
 }
+
+// price uses the stored quote when the client created the order from one, so
+// they pay what they were shown; otherwise it prices at the current rates.
+func (uc *CreateOrderUseCase) price(ctx context.Context, order *domain.Order, clientID, quoteID string) (*domain.PriceQuote, error) {
+	if quoteID == "" {
+		quote, err := uc.pricing.Quote(ctx, order.OriginCoords, order.DestinationCoords, order.PackageSize, order.ServiceType)
+		if err != nil {
+			return nil, pricingError(uc.logger, err)
+		}
+		return quote, nil
+	}
+
+	stored, err := uc.quoteRepo.GetByID(ctx, quoteID)
+	if err != nil || stored.ClientID != clientID {
+		uc.logger.Warn("Quote not found", logger.String("quote_id", quoteID), logger.Error(err))
+		return nil, appErrors.NewValidationError("quote not found")
+	}
+
+	quote, err := stored.PriceFor(order, time.Now())
+	if err != nil {
+		uc.logger.Warn("Quote cannot be used for this order", logger.String("quote_id", quoteID), logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	order.QuoteID = stored.ID
+	return quote, nil
+}
+
+func (uc *CreateOrderUseCase) scheduleWindows(order *domain.Order, req dto.CreateOrderRequest) error {
+	pickup, err := dto.ToTimeWindow(req.PickupWindow)
+	if err != nil {
+		return fmt.Errorf("pickup_window: %w", err)
+	}
+
+	delivery, err := dto.ToTimeWindow(req.DeliveryWindow)
+	if err != nil {
+		return fmt.Errorf("delivery_window: %w", err)
+	}
+
+	return order.ScheduleWindows(pickup, delivery)
+}
+
+// buildParcelSpecs turns the request into parcels. Requests without a parcel
+// list describe a single piece through total_weight and dimensions.
+func buildParcelSpecs(req dto.CreateOrderRequest) ([]domain.ParcelSpec, error) {
+	if len(req.Parcels) == 0 {
+		return []domain.ParcelSpec{{Weight: req.TotalWeight, Dimensions: req.Dimensions}}, nil
+	}
+
+	if req.Dimensions != nil {
+		return nil, errors.New("dimensions must be set per parcel when parcels are provided")
+	}
+
+	specs := make([]domain.ParcelSpec, len(req.Parcels))
+	var totalWeight float64
+	for i, parcel := range req.Parcels {
+		specs[i] = domain.ParcelSpec{Weight: parcel.Weight, Dimensions: parcel.Dimensions}
+		totalWeight += parcel.Weight
+	}
+
+	if req.TotalWeight > 0 && math.Abs(req.TotalWeight-totalWeight) > 0.01 {
+		return nil, errors.New("total_weight does not match the sum of parcel weights")
+	}
+
+	return specs, nil
+}
+
+// declareValue records the declared value and, when requested, insures it.
+func (uc *CreateOrderUseCase) declareValue(order *domain.Order, req dto.CreateOrderRequest) error {
+	if req.DeclaredValue == nil {
+		if req.DeclaredValueCurrency != "" {
+			return errors.New("declared_value_currency requires declared_value")
+		}
+		if req.Insured {
+			return errors.New("insurance requires a declared value")
+		}
+		return nil
+	}
+
+	if err := order.DeclareValue(*req.DeclaredValue, req.DeclaredValueCurrency); err != nil {
+		return err
+	}
+	if req.Insured {
+		return order.Insure(uc.insurance)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type DeleteOrderUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	logger    logger.Logger
+}
+
+func NewDeleteOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	logger logger.Logger,
+) *DeleteOrderUseCase {
+	return &DeleteOrderUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		logger:    logger,
+	}
+}
+
+// Execute soft-deletes the order. The row stays in place, hidden from every
+// listing, until it is restored or archived. Who deleted it and why is also
+// kept in the history, so it survives a restore.
+func (uc *DeleteOrderUseCase) Execute(ctx context.Context, orderID, adminID string, req dto.DeleteOrderRequest) (*dto.OrderResponse, error) {
+	uc.logger.Info("Deleting order",
+		logger.String("order_id", orderID),
+		logger.String("admin_id", adminID),
+	)
+
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		uc.logger.Warn("Order not found", logger.String("order_id", orderID))
+		return nil, appErrors.NewNotFoundError("order")
+	}
+
+	if err := order.SoftDelete(adminID, req.Reason, time.Now()); err != nil {
+		uc.logger.Warn("Order cannot be deleted",
+			logger.String("order_id", orderID),
+			logger.String("current_status", string(order.Status)),
+			logger.Error(err),
+		)
+		if errors.Is(err, domain.ErrOrderInOperation) {
+			return nil, appErrors.NewConflictError(err.Error())
+		}
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	event, err := domain.NewOrderActionEvent(order, domain.OrderEventDeleted, adminID, req.Reason)
+	if err != nil {
+		uc.logger.Error("Failed to create deletion event", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to delete order", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Order deleted successfully",
+		logger.String("order_id", orderID),
+		logger.String("admin_id", adminID),
+	)
+
+	return dto.ToOrderResponse(order), nil
+}
@@ -0,0 +1,228 @@
+package order
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type DeliverOrderUseCase struct {
+	orderRepo repositories.OrderRepository
+	proofRepo repositories.ProofOfDeliveryRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	workflows services.WorkflowProvider
+	storage   services.FileStorage
+	logger    logger.Logger
+}
+
+func NewDeliverOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	proofRepo repositories.ProofOfDeliveryRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	workflows services.WorkflowProvider,
+	storage services.FileStorage,
+	logger logger.Logger,
+) *DeliverOrderUseCase {
+	return &DeliverOrderUseCase{
+		orderRepo: orderRepo,
+		proofRepo: proofRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		workflows: workflows,
+		storage:   storage,
+		logger:    logger,
+	}
+}
+
+type proofUpload struct {
+	key     string
+	content []byte
+}
+
+func (uc *DeliverOrderUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole, req dto.DeliverOrderRequest, signature, photo *dto.ProofFile) (*dto.OrderResponse, error) {
+	uc.logger.Info("Delivering order",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+	)
+
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	workflow, err := uc.workflows.GetWorkflow(ctx, order.ServiceType)
+	if err != nil {
+		uc.logger.Error("Failed to resolve workflow",
+			logger.String("service_type", string(order.ServiceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	location := domain.Coordinates{Latitude: *req.Latitude, Longitude: *req.Longitude}
+	proof, err := domain.NewProofOfDelivery(order.ID, req.RecipientName, req.Relationship, location, userID)
+	if err != nil {
+		uc.logger.Warn("Invalid proof of delivery", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	var uploads []proofUpload
+	for kind, file := range map[domain.ProofFileKind]*dto.ProofFile{
+		domain.ProofFileSignature: signature,
+		domain.ProofFilePhoto:     photo,
+	} {
+		if file == nil {
+			continue
+		}
+		upload, err := readProofFile(proof, kind, file)
+		if err != nil {
+			uc.logger.Warn("Invalid proof file", logger.String("kind", string(kind)), logger.Error(err))
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+		uploads = append(uploads, *upload)
+	}
+
+	if err := collectCash(order, userID, req, time.Now()); err != nil {
+		uc.logger.Warn("Invalid cash collection",
+			logger.String("order_id", orderID),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	previousStatus := order.Status
+	if err := order.Deliver(workflow, userRole, proof); err != nil {
+		if errors.Is(err, domain.ErrTransitionNotPermitted) {
+			return nil, appErrors.NewForbiddenError()
+		}
+
+		uc.logger.Warn("Order cannot be delivered",
+			logger.String("order_id", orderID),
+			logger.String("current_status", string(order.Status)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	event, err := domain.NewOrderStatusEvent(order.ID, previousStatus, order.Status, userID, "delivered to "+proof.RecipientName, req.Location)
+	if err != nil {
+		uc.logger.Error("Failed to create status event", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	for i, upload := range uploads {
+		if err := uc.storage.Save(ctx, upload.key, bytes.NewReader(upload.content)); err != nil {
+			uc.logger.Error("Failed to store proof file", logger.String("key", upload.key), logger.Error(err))
+			uc.discard(ctx, uploads[:i])
+			return nil, appErrors.NewInternalError()
+		}
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		if err := uc.proofRepo.Create(ctx, proof); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to deliver order", logger.Error(err))
+		uc.discard(ctx, uploads)
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Order delivered successfully",
+		logger.String("order_id", orderID),
+		logger.String("recipient", proof.RecipientName),
+	)
+	if order.CashDiscrepancy() != 0 {
+		uc.logger.Warn("Cash collected differs from the expected amount",
+			logger.String("order_id", orderID),
+			logger.String("driver_id", order.CODDriverID),
+		)
+	}
+
+	return dto.ToOrderResponse(order), nil
+}
+
+// collectCash confirms the amount collected at the door for cash-on-delivery
+// orders; other orders must not report one.
+func collectCash(order *domain.Order, userID string, req dto.DeliverOrderRequest, now time.Time) error {
+	if !order.RequiresCashCollection() {
+		if req.CollectedAmount != nil {
+			return errors.New("order has no cash on delivery amount")
+		}
+		return nil
+	}
+
+	if req.CollectedAmount == nil {
+		return errors.New("collected_amount is required for cash on delivery orders")
+	}
+
+	driverID := req.DriverID
+	if driverID == "" {
+		driverID = userID
+	}
+	return order.CollectCash(driverID, *req.CollectedAmount, now)
+}
+
+// discard removes files stored for a delivery that could not be saved.
+func (uc *DeliverOrderUseCase) discard(ctx context.Context, uploads []proofUpload) {
+	for _, upload := range uploads {
+		if err := uc.storage.Delete(ctx, upload.key); err != nil {
+			uc.logger.Warn("Failed to remove orphaned proof file", logger.String("key", upload.key), logger.Error(err))
+		}
+	}
+}
+
+// readProofFile loads an upload, checks its size and type, and assigns its
+// storage key on the proof.
+func readProofFile(proof *domain.ProofOfDelivery, kind domain.ProofFileKind, file *dto.ProofFile) (*proofUpload, error) {
+	if file.Size > domain.MaxProofFileSize {
+		return nil, fmt.Errorf("%s exceeds %d MB", kind, domain.MaxProofFileSize>>20)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(file.Content, domain.MaxProofFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s", kind)
+	}
+	if len(content) == 0 {
+		return nil, fmt.Errorf("%s is empty", kind)
+	}
+	if len(content) > domain.MaxProofFileSize {
+		return nil, fmt.Errorf("%s exceeds %d MB", kind, domain.MaxProofFileSize>>20)
+	}
+
+	key, err := proof.FileKey(kind, http.DetectContentType(content))
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case domain.ProofFileSignature:
+		proof.SignatureKey = key
+	case domain.ProofFilePhoto:
+		proof.PhotoKey = key
+	}
+
+	return &proofUpload{key: key, content: content}, nil
+}
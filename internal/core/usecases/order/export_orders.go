@@ -0,0 +1,121 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+// exportBatchSize is how many orders are read per query while exporting.
+const exportBatchSize = 500
+
+type ExportOrdersUseCase struct {
+	orderRepo repositories.OrderRepository
+	logger    logger.Logger
+}
+
+func NewExportOrdersUseCase(
+	orderRepo repositories.OrderRepository,
+	logger logger.Logger,
+) *ExportOrdersUseCase {
+	return &ExportOrdersUseCase{
+		orderRepo: orderRepo,
+		logger:    logger,
+	}
+}
+
+// Execute streams every matching order to the sink in keyset batches, so
+// memory stays flat regardless of the export size. Errors returned before
+// the sink's header is written can still be reported to the client.
+func (uc *ExportOrdersUseCase) Execute(ctx context.Context, userID string, userRole domain.UserRole, req dto.ExportOrdersRequest, sink dto.OrderExportSink) error {
+	uc.logger.Info("Exporting orders",
+		logger.String("user_id", userID),
+		logger.String("format", req.Format),
+	)
+
+	filter, err := scopeFilterForUser(userID, userRole, req.Filters)
+	if err != nil {
+		uc.logger.Warn("Client attempted to export orders outside their scope",
+			logger.String("user_id", userID),
+			logger.Error(err),
+		)
+		return appErrors.NewForbiddenError()
+	}
+
+	sort := toOrderSort(req.Filters)
+	if sort.Field != repositories.OrderSortCreatedAt {
+		return appErrors.NewValidationError("exports only support sort_by=created_at")
+	}
+
+	columns := req.Columns
+	if len(columns) == 0 {
+		columns = dto.DefaultExportColumns
+	}
+	if err := dto.ValidateExportColumns(columns); err != nil {
+		return appErrors.NewValidationError(err.Error())
+	}
+
+	loc, err := exportLocation(req.TimeZone)
+	if err != nil {
+		return appErrors.NewValidationError("time_zone must be an IANA time zone")
+	}
+
+	batch, err := uc.orderRepo.ListAfter(ctx, filter, nil, sort.Ascending, exportBatchSize)
+	if err != nil {
+		uc.logger.Error("Failed to read orders for export", logger.Error(err))
+		return appErrors.NewInternalError()
+	}
+
+	if err := sink.WriteHeader(columns); err != nil {
+		return err
+	}
+
+	exported := 0
+	for {
+		for _, order := range batch {
+			if err := sink.WriteRow(dto.ToExportRow(order, columns, loc)); err != nil {
+				return err
+			}
+		}
+		exported += len(batch)
+
+		if err := sink.Flush(); err != nil {
+			return err
+		}
+
+		if len(batch) < exportBatchSize {
+			break
+		}
+
+		last := batch[len(batch)-1]
+		batch, err = uc.orderRepo.ListAfter(ctx, filter, &repositories.OrderCursor{CreatedAt: last.CreatedAt, ID: last.ID}, sort.Ascending, exportBatchSize)
+		if err != nil {
+			uc.logger.Error("Failed to read orders for export", logger.Error(err))
+			return err
+		}
+	}
+
+	uc.logger.Info("Orders exported",
+		logger.String("user_id", userID),
+		logger.Int("orders", exported),
+	)
+	return nil
+}
+
+// exportLocation resolves the IANA zone timestamps are written in; the
+// server's "Local" zone is not accepted.
+func exportLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+	if timeZone == "Local" {
+		return nil, fmt.Errorf("invalid time zone %q", timeZone)
+	}
+	return time.LoadLocation(timeZone)
+}
@@ -0,0 +1,84 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type FileClaimUseCase struct {
+	orderRepo repositories.OrderRepository
+	claimRepo repositories.ClaimRepository
+	logger    logger.Logger
+}
+
+func NewFileClaimUseCase(
+	orderRepo repositories.OrderRepository,
+	claimRepo repositories.ClaimRepository,
+	logger logger.Logger,
+) *FileClaimUseCase {
+	return &FileClaimUseCase{
+		orderRepo: orderRepo,
+		claimRepo: claimRepo,
+		logger:    logger,
+	}
+}
+
+// Execute files a loss or damage claim on one of the client's orders. An
+// order can only have one claim under review at a time.
+func (uc *FileClaimUseCase) Execute(ctx context.Context, orderID, clientID string, req dto.FileClaimRequest) (*dto.ClaimResponse, error) {
+	uc.logger.Info("Filing claim",
+		logger.String("order_id", orderID),
+		logger.String("client_id", clientID),
+		logger.String("type", string(req.Type)),
+	)
+
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, clientID, domain.ClientRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("client_id", clientID),
+		)
+		return nil, err
+	}
+
+	claims, err := uc.claimRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Failed to get order claims", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+	for _, claim := range claims {
+		if claim.IsOpen() {
+			return nil, appErrors.NewConflictError(domain.ErrClaimAlreadyOpen.Error())
+		}
+	}
+
+	claim, err := domain.NewClaim(order, req.Type, req.Description, req.ClaimedAmount)
+	if err != nil {
+		if errors.Is(err, domain.ErrClaimNotEligible) {
+			return nil, appErrors.NewConflictError(err.Error())
+		}
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if err := uc.claimRepo.Create(ctx, claim); err != nil {
+		if errors.Is(err, domain.ErrClaimAlreadyOpen) {
+			return nil, appErrors.NewConflictError(err.Error())
+		}
+		uc.logger.Error("Failed to save claim", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	uc.logger.Info("Claim filed successfully",
+		logger.String("order_id", orderID),
+		logger.String("claim_id", claim.ID),
+		logger.Float64("claimed_amount", claim.ClaimedAmount),
+	)
+
+	return dto.ToClaimResponse(claim), nil
+}
@@ -0,0 +1,48 @@
+package order
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetDeliveryAttemptsUseCase struct {
+	orderRepo   repositories.OrderRepository
+	attemptRepo repositories.DeliveryAttemptRepository
+	logger      logger.Logger
+}
+
+func NewGetDeliveryAttemptsUseCase(
+	orderRepo repositories.OrderRepository,
+	attemptRepo repositories.DeliveryAttemptRepository,
+	logger logger.Logger,
+) *GetDeliveryAttemptsUseCase {
+	return &GetDeliveryAttemptsUseCase{
+		orderRepo:   orderRepo,
+		attemptRepo: attemptRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *GetDeliveryAttemptsUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole) ([]*dto.DeliveryAttemptResponse, error) {
+	uc.logger.Info("Getting delivery attempts", logger.String("order_id", orderID))
+
+	if _, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole); err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	attempts, err := uc.attemptRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Failed to get delivery attempts", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	return dto.ToDeliveryAttemptResponseList(attempts), nil
+}
@@ -0,0 +1,53 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetImportJobUseCase struct {
+	jobRepo repositories.ImportJobRepository
+	logger  logger.Logger
+}
+
+func NewGetImportJobUseCase(
+	jobRepo repositories.ImportJobRepository,
+	logger logger.Logger,
+) *GetImportJobUseCase {
+	return &GetImportJobUseCase{
+		jobRepo: jobRepo,
+		logger:  logger,
+	}
+}
+
+func (uc *GetImportJobUseCase) Execute(ctx context.Context, jobID, userID string, userRole domain.UserRole) (*dto.ImportJobResponse, error) {
+	uc.logger.Info("Getting import job",
+		logger.String("job_id", jobID),
+		logger.String("user_id", userID),
+	)
+
+	job, err := uc.jobRepo.GetByID(ctx, jobID)
+	if err != nil && !errors.Is(err, domain.ErrImportJobNotFound) {
+		uc.logger.Error("Failed to get import job",
+			logger.String("job_id", jobID),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	if err != nil || !job.CanBeViewedBy(userID, userRole) {
+		uc.logger.Warn("Import job not available to user",
+			logger.String("job_id", jobID),
+			logger.String("user_id", userID),
+		)
+		return nil, appErrors.NewNotFoundError("import job")
+	}
+
+	return dto.ToImportJobResponse(job), nil
+}
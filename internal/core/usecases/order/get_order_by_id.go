@@ -0,0 +1,73 @@
+package order
+
+import (
+	"context"
+	"math"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetOrderByIDUseCase struct {
+	orderRepo    repositories.OrderRepository
+	coordService services.CoordinateService
+	logger       logger.Logger
+}
+
+func NewGetOrderByIDUseCase(
+	orderRepo repositories.OrderRepository,
+	coordService services.CoordinateService,
+	logger logger.Logger,
+) *GetOrderByIDUseCase {
+	return &GetOrderByIDUseCase{
+		orderRepo:    orderRepo,
+		coordService: coordService,
+		logger:       logger,
+	}
+}
+
+func (uc *GetOrderByIDUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole) (*dto.OrderResponse, error) {
+	uc.logger.Info("Getting order",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+	)
+
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	response := dto.ToOrderResponse(order)
+
+	distance, err := uc.coordService.GetDistanceBetweenPoints(ctx, order.OriginCoords, order.DestinationCoords)
+	if err != nil {
+		uc.logger.Warn("Failed to compute order distance", logger.Error(err))
+	} else {
+		response.DistanceKm = math.Round(distance*100) / 100
+	}
+
+	return response, nil
+}
+
+// loadVisibleOrder returns the order only when the user may read it. Orders
+// owned by someone else are reported as not found so IDs can't be enumerated.
+func loadVisibleOrder(ctx context.Context, orderRepo repositories.OrderRepository, orderID, userID string, userRole domain.UserRole) (*domain.Order, error) {
+	order, err := orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, appErrors.NewNotFoundError("order")
+	}
+
+	if !order.CanBeViewedBy(userID, userRole) {
+		return nil, appErrors.NewNotFoundError("order")
+	}
+
+	return order, nil
+}
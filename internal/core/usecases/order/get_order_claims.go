@@ -0,0 +1,52 @@
+package order
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetOrderClaimsUseCase struct {
+	orderRepo repositories.OrderRepository
+	claimRepo repositories.ClaimRepository
+	logger    logger.Logger
+}
+
+func NewGetOrderClaimsUseCase(
+	orderRepo repositories.OrderRepository,
+	claimRepo repositories.ClaimRepository,
+	logger logger.Logger,
+) *GetOrderClaimsUseCase {
+	return &GetOrderClaimsUseCase{
+		orderRepo: orderRepo,
+		claimRepo: claimRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *GetOrderClaimsUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole) ([]*dto.ClaimResponse, error) {
+	uc.logger.Info("Getting order claims",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+	)
+
+	if _, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole); err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	claims, err := uc.claimRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Failed to get order claims", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	return dto.ToClaimResponseList(claims), nil
+}
@@ -0,0 +1,59 @@
+package order
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetOrderHistoryUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	logger    logger.Logger
+}
+
+func NewGetOrderHistoryUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	logger logger.Logger,
+) *GetOrderHistoryUseCase {
+	return &GetOrderHistoryUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *GetOrderHistoryUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole) (*dto.OrderHistoryResponse, error) {
+	uc.logger.Info("Getting order history",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+	)
+
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	events, err := uc.eventRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Failed to get order history", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	visible := make([]*domain.OrderStatusEvent, 0, len(events))
+	for _, event := range events {
+		if event.CanBeViewedBy(userRole) {
+			visible = append(visible, event)
+		}
+	}
+
+	return dto.ToOrderHistoryResponse(order, visible), nil
+}
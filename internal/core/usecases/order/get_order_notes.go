@@ -0,0 +1,61 @@
+package order
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetOrderNotesUseCase struct {
+	orderRepo repositories.OrderRepository
+	noteRepo  repositories.OrderNoteRepository
+	logger    logger.Logger
+}
+
+func NewGetOrderNotesUseCase(
+	orderRepo repositories.OrderRepository,
+	noteRepo repositories.OrderNoteRepository,
+	logger logger.Logger,
+) *GetOrderNotesUseCase {
+	return &GetOrderNotesUseCase{
+		orderRepo: orderRepo,
+		noteRepo:  noteRepo,
+		logger:    logger,
+	}
+}
+
+// Execute lists the order's notes oldest first. Clients only get the
+// client-visible ones.
+func (uc *GetOrderNotesUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole) ([]*dto.OrderNoteResponse, error) {
+	uc.logger.Info("Getting order notes",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+	)
+
+	if _, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole); err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	notes, err := uc.noteRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Failed to get order notes", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	visible := make([]*domain.OrderNote, 0, len(notes))
+	for _, note := range notes {
+		if note.CanBeViewedBy(userRole) {
+			visible = append(visible, note)
+		}
+	}
+
+	return dto.ToOrderNoteResponseList(visible), nil
+}
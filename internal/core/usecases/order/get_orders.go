@@ -2,9 +2,12 @@ package order
 
 import (
 	"context"
+	"errors"
 	"math"
+	"time"
 
 	"logistics-api/internal/core/domain"
+
 	"logistics-api/internal/core/ports/repositories"
 	"logistics-api/internal/core/usecases/dto"
 	appErrors "logistics-api/internal/pkg/errors"
@@ -32,6 +35,35 @@ func NewGetOrdersUseCase(
 func (uc *GetOrdersUseCase) ExecuteForClient(ctx context.Context, clientID string, req dto.ListOrdersRequest) (*dto.ListOrdersResponse, error) {
 	uc.logger.Info("Getting orders for client", logger.String("client_id", clientID))
 
+	filter, err := scopeFilterForUser(clientID, domain.ClientRole, req)
+	if err != nil {
+		uc.logger.Warn("Client attempted to list orders outside their scope",
+			logger.String("client_id", clientID),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewForbiddenError()
+	}
+	return uc.list(ctx, filter, req)
+}
+
+func (uc *GetOrdersUseCase) ExecuteForAdmin(ctx context.Context, req dto.ListOrdersRequest) (*dto.ListOrdersResponse, error) {
+	uc.logger.Info("Getting all orders for admin")
+
+	return uc.list(ctx, toOrderFilter(req), req)
+}
+
+// ExecuteOverdue lists open orders whose promised delivery date has passed.
+func (uc *GetOrdersUseCase) ExecuteOverdue(ctx context.Context, req dto.ListOrdersRequest) (*dto.ListOrdersResponse, error) {
+	uc.logger.Info("Getting overdue orders")
+
+	now := time.Now()
+	filter := toOrderFilter(req)
+	filter.PromisedBefore = &now
+	filter.ExcludeStatuses = domain.GetClosedStatuses()
+	return uc.list(ctx, filter, req)
+}
+
+func (uc *GetOrdersUseCase) list(ctx context.Context, filter repositories.OrderFilter, req dto.ListOrdersRequest) (*dto.ListOrdersResponse, error) {
 	if req.Page < 1 {
 		req.Page = 1
 	}
@@ -39,15 +71,19 @@ func (uc *GetOrdersUseCase) ExecuteForClient(ctx context.Context, clientID strin
 		req.Limit = 10
 	}
 
+	if req.UsesCursor() {
+		return uc.listAfterCursor(ctx, filter, req)
+	}
+
 	offset := (req.Page - 1) * req.Limit
 
-	orders, err := uc.orderRepo.GetByClientID(ctx, clientID, req.Limit, offset)
+	orders, err := uc.orderRepo.List(ctx, filter, toOrderSort(req), req.Limit, offset)
 	if err != nil {
 		uc.logger.Error("Failed to get orders", logger.Error(err))
 		return nil, appErrors.NewInternalError()
 	}
 
-	total, err := uc.orderRepo.CountByClientID(ctx, clientID)
+	total, err := uc.orderRepo.Count(ctx, filter)
 	if err != nil {
 		uc.logger.Error("Failed to count orders", logger.Error(err))
 		return nil, appErrors.NewInternalError()
@@ -57,57 +93,103 @@ func (uc *GetOrdersUseCase) ExecuteForClient(ctx context.Context, clientID strin
 
 	return &dto.ListOrdersResponse{
 		Orders:     dto.ToOrderResponseList(orders),
-		Total:      total,
+		Total:      &total,
 		Page:       req.Page,
 		Limit:      req.Limit,
-		TotalPages: totalPages,
+		TotalPages: &totalPages,
 	}, nil
 }
 
-func (uc *GetOrdersUseCase) ExecuteForAdmin(ctx context.Context, req dto.ListOrdersRequest) (*dto.ListOrdersResponse, error) {
-	uc.logger.Info("Getting all orders for admin")
-
-	if req.Page < 1 {
-		req.Page = 1
+// listAfterCursor serves keyset pages ordered by (created_at, id). It skips
+// the count unless the client asks for it.
+func (uc *GetOrdersUseCase) listAfterCursor(ctx context.Context, filter repositories.OrderFilter, req dto.ListOrdersRequest) (*dto.ListOrdersResponse, error) {
+	sort := toOrderSort(req)
+	if sort.Field != repositories.OrderSortCreatedAt {
+		return nil, appErrors.NewValidationError("cursor pagination only supports sort_by=created_at")
 	}
-	if req.Limit < 1 || req.Limit > 100 {
-		req.Limit = 10
+
+	var after *repositories.OrderCursor
+	if req.Cursor != "" {
+		cursor, err := decodeOrderCursor(req.Cursor)
+		if err != nil {
+			uc.logger.Warn("Invalid cursor", logger.Error(err))
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+		after = cursor
 	}
 
-	offset := (req.Page - 1) * req.Limit
+	// One extra row tells whether another page follows.
+	orders, err := uc.orderRepo.ListAfter(ctx, filter, after, sort.Ascending, req.Limit+1)
+	if err != nil {
+		uc.logger.Error("Failed to get orders", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
 
-	var orders []*domain.Order
-	var total int64
-	var err error
+	response := &dto.ListOrdersResponse{Limit: req.Limit}
+	if len(orders) > req.Limit {
+		orders = orders[:req.Limit]
+		response.NextCursor = encodeOrderCursor(orders[len(orders)-1])
+	}
+	response.Orders = dto.ToOrderResponseList(orders)
 
-	if req.Status != "" {
-		orders, err = uc.orderRepo.GetByStatus(ctx, req.Status, req.Limit, offset)
-		if err != nil {
-			uc.logger.Error("Failed to get orders by status", logger.Error(err))
-			return nil, appErrors.NewInternalError()
-		}
-		total, err = uc.orderRepo.CountByStatus(ctx, req.Status)
-	} else {
-		orders, err = uc.orderRepo.GetAll(ctx, req.Limit, offset)
+	if req.IncludeTotal {
+		total, err := uc.orderRepo.Count(ctx, filter)
 		if err != nil {
-			uc.logger.Error("Failed to get all orders", logger.Error(err))
+			uc.logger.Error("Failed to count orders", logger.Error(err))
 			return nil, appErrors.NewInternalError()
 		}
-		total, err = uc.orderRepo.CountTotal(ctx)
+		response.Total = &total
 	}
 
-	if err != nil {
-		uc.logger.Error("Failed to count orders", logger.Error(err))
-		return nil, appErrors.NewInternalError()
+	return response, nil
+}
+
+// scopeFilterForUser builds the listing filter and limits clients to their own
+// live orders. It fails when a client asks for another client's orders or for
+// deleted ones.
+func scopeFilterForUser(userID string, userRole domain.UserRole, req dto.ListOrdersRequest) (repositories.OrderFilter, error) {
+	filter := toOrderFilter(req)
+	if userRole == domain.AdminRole {
+		return filter, nil
 	}
 
-	totalPages := int(math.Ceil(float64(total) / float64(req.Limit)))
+	if req.ClientID != "" && req.ClientID != userID {
+		return filter, errors.New("client_id filter names another client")
+	}
+	if req.Deleted {
+		return filter, errors.New("deleted orders are only listed for admins")
+	}
 
-	return &dto.ListOrdersResponse{
-		Orders:     dto.ToOrderResponseList(orders),
-		Total:      total,
-		Page:       req.Page,
-		Limit:      req.Limit,
-		TotalPages: totalPages,
-	}, nil
+	filter.ClientID = userID
+	return filter, nil
+}
+
+func toOrderFilter(req dto.ListOrdersRequest) repositories.OrderFilter {
+	return repositories.OrderFilter{
+		ClientID:             req.ClientID,
+		Statuses:             req.Statuses,
+		PackageSize:          req.PackageSize,
+		CreatedFrom:          req.CreatedFrom,
+		CreatedTo:            req.CreatedTo,
+		PickupFrom:           req.PickupFrom,
+		PickupTo:             req.PickupTo,
+		DeliveryFrom:         req.DeliveryFrom,
+		DeliveryTo:           req.DeliveryTo,
+		OriginCity:           req.OriginCity,
+		DestinationCity:      req.DestinationCity,
+		OriginZipPrefix:      req.OriginZipPrefix,
+		DestinationZipPrefix: req.DestinationZipPrefix,
+		OnlyDeleted:          req.Deleted,
+	}
+}
+
+func toOrderSort(req dto.ListOrdersRequest) repositories.OrderSort {
+	sort := repositories.OrderSort{
+		Field:     repositories.OrderSortField(req.SortBy),
+		Ascending: req.SortOrder == "asc",
+	}
+	if sort.Field == "" {
+		sort.Field = repositories.OrderSortCreatedAt
+	}
+	return sort
 }
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"testing"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/usecases/dto"
+)
+
+func TestScopeFilterForUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		userRole     domain.UserRole
+		req          dto.ListOrdersRequest
+		wantClientID string
+		wantErr      bool
+	}{
+		{name: "client sees own orders", userRole: domain.ClientRole, wantClientID: "user-1"},
+		{name: "client filtering by self", userRole: domain.ClientRole, req: dto.ListOrdersRequest{ClientID: "user-1"}, wantClientID: "user-1"},
+		{name: "client filtering by another client", userRole: domain.ClientRole, req: dto.ListOrdersRequest{ClientID: "user-2"}, wantErr: true},
+		{name: "client asking for deleted orders", userRole: domain.ClientRole, req: dto.ListOrdersRequest{Deleted: true}, wantErr: true},
+		{name: "admin sees every client", userRole: domain.AdminRole},
+		{name: "admin filtering by client", userRole: domain.AdminRole, req: dto.ListOrdersRequest{ClientID: "user-2"}, wantClientID: "user-2"},
+		{name: "admin asking for deleted orders", userRole: domain.AdminRole, req: dto.ListOrdersRequest{Deleted: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := scopeFilterForUser("user-1", tt.userRole, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("scopeFilterForUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && filter.ClientID != tt.wantClientID {
+				t.Errorf("ClientID = %q, want %q", filter.ClientID, tt.wantClientID)
+			}
+		})
+	}
+}
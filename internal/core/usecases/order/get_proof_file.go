@@ -0,0 +1,62 @@
+package order
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetProofFileUseCase struct {
+	orderRepo repositories.OrderRepository
+	storage   services.FileStorage
+	logger    logger.Logger
+}
+
+func NewGetProofFileUseCase(
+	orderRepo repositories.OrderRepository,
+	storage services.FileStorage,
+	logger logger.Logger,
+) *GetProofFileUseCase {
+	return &GetProofFileUseCase{
+		orderRepo: orderRepo,
+		storage:   storage,
+		logger:    logger,
+	}
+}
+
+// Execute returns the requested proof file and its content type. The caller
+// must close the reader.
+func (uc *GetProofFileUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole, kind domain.ProofFileKind) (io.ReadCloser, string, error) {
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, "", err
+	}
+
+	if order.ProofOfDelivery == nil || order.ProofOfDelivery.KeyFor(kind) == "" {
+		return nil, "", appErrors.NewNotFoundError("proof of delivery " + string(kind))
+	}
+
+	key := order.ProofOfDelivery.KeyFor(kind)
+	file, err := uc.storage.Open(ctx, key)
+	if err != nil {
+		uc.logger.Error("Failed to open proof file", logger.String("key", key), logger.Error(err))
+		return nil, "", appErrors.NewInternalError()
+	}
+
+	contentType := "image/png"
+	if path.Ext(key) == ".jpg" {
+		contentType = "image/jpeg"
+	}
+
+	return file, contentType, nil
+}
@@ -0,0 +1,218 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+	"logistics-api/internal/pkg/validator"
+)
+
+// importProgressInterval is how many rows a background import processes
+// between progress saves.
+const importProgressInterval = 25
+
+// errImportQueueFull is returned when every background slot is taken.
+var errImportQueueFull = appErrors.NewServiceUnavailableError("too many imports in progress; try again later")
+
+type importTask struct {
+	job  *domain.ImportJob
+	rows []dto.ImportOrderRow
+}
+
+type ImportOrdersUseCase struct {
+	createOrder *CreateOrderUseCase
+	jobRepo     repositories.ImportJobRepository
+	validator   *validator.Validator
+	syncRows    int
+	queue       chan importTask
+	logger      logger.Logger
+}
+
+// NewImportOrdersUseCase holds up to queueSize background imports waiting for
+// a worker; StartWorkers must be called for them to run.
+func NewImportOrdersUseCase(
+	createOrder *CreateOrderUseCase,
+	jobRepo repositories.ImportJobRepository,
+	validator *validator.Validator,
+	syncRows int,
+	queueSize int,
+	logger logger.Logger,
+) *ImportOrdersUseCase {
+	return &ImportOrdersUseCase{
+		createOrder: createOrder,
+		jobRepo:     jobRepo,
+		validator:   validator,
+		syncRows:    syncRows,
+		queue:       make(chan importTask, queueSize),
+		logger:      logger,
+	}
+}
+
+// StartWorkers runs background imports, at most workers at a time.
+func (uc *ImportOrdersUseCase) StartWorkers(workers int) {
+	for i := 0; i < workers; i++ {
+		go func() {
+			for task := range uc.queue {
+				uc.runInBackground(task.job, task.rows)
+			}
+		}()
+	}
+}
+
+// Execute imports small batches inline and returns the finished report.
+// Larger batches are saved as a pending job and processed in the background,
+// or rejected when the queue is full.
+func (uc *ImportOrdersUseCase) Execute(ctx context.Context, clientID string, rows []dto.ImportOrderRow) (*dto.ImportJobResponse, error) {
+	uc.logger.Info("Importing orders",
+		logger.String("client_id", clientID),
+		logger.Int("rows", len(rows)),
+	)
+
+	job, err := domain.NewImportJob(clientID, len(rows))
+	if err != nil {
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if len(rows) <= uc.syncRows {
+		job.Start(time.Now())
+		uc.process(ctx, job, rows, nil)
+		job.Finish(time.Now())
+
+		// The orders already exist, so the report is returned even when the
+		// job record can't be saved.
+		if err := uc.jobRepo.Create(ctx, job); err != nil {
+			uc.logger.Error("Failed to save import job", logger.Error(err))
+		}
+
+		uc.logger.Info("Import completed",
+			logger.String("job_id", job.ID),
+			logger.Int("succeeded", job.Succeeded),
+			logger.Int("failed", job.Failed),
+		)
+		return dto.ToImportJobResponse(job), nil
+	}
+
+	// Checked before saving so a busy server doesn't pile up failed jobs; the
+	// send below still covers a queue that fills up in between.
+	if len(uc.queue) == cap(uc.queue) {
+		uc.logger.Warn("Import queue is full", logger.String("client_id", clientID))
+		return nil, errImportQueueFull
+	}
+
+	if err := uc.jobRepo.Create(ctx, job); err != nil {
+		uc.logger.Error("Failed to save import job", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	response := dto.ToImportJobResponse(job)
+	select {
+	case uc.queue <- importTask{job: job, rows: rows}:
+	default:
+		uc.logger.Warn("Import queue is full", logger.String("job_id", job.ID))
+		job.Abort("import queue is full", time.Now())
+		uc.saveProgress(ctx, job)
+		return nil, errImportQueueFull
+	}
+
+	uc.logger.Info("Import queued", logger.String("job_id", job.ID))
+	return response, nil
+}
+
+// RecoverInterrupted fails jobs left unfinished by a previous process, since
+// their rows are no longer held anywhere.
+func (uc *ImportOrdersUseCase) RecoverInterrupted(ctx context.Context) error {
+	count, err := uc.jobRepo.AbortUnfinished(ctx, "import interrupted by a server restart")
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		uc.logger.Warn("Aborted interrupted import jobs", logger.Int("count", int(count)))
+	}
+	return nil
+}
+
+func (uc *ImportOrdersUseCase) runInBackground(job *domain.ImportJob, rows []dto.ImportOrderRow) {
+	ctx := context.Background()
+
+	defer func() {
+		if r := recover(); r != nil {
+			uc.logger.Error("Import job panicked", logger.String("job_id", job.ID), logger.Error(fmt.Errorf("%v", r)))
+			job.Abort("internal error", time.Now())
+			uc.saveProgress(ctx, job)
+		}
+	}()
+
+	job.Start(time.Now())
+	uc.saveProgress(ctx, job)
+
+	uc.process(ctx, job, rows, func() {
+		if job.Processed()%importProgressInterval == 0 {
+			uc.saveProgress(ctx, job)
+		}
+	})
+
+	job.Finish(time.Now())
+	uc.saveProgress(ctx, job)
+
+	uc.logger.Info("Import completed",
+		logger.String("job_id", job.ID),
+		logger.Int("succeeded", job.Succeeded),
+		logger.Int("failed", job.Failed),
+	)
+}
+
+func (uc *ImportOrdersUseCase) saveProgress(ctx context.Context, job *domain.ImportJob) {
+	if err := uc.jobRepo.Update(ctx, job); err != nil {
+		uc.logger.Error("Failed to save import progress",
+			logger.String("job_id", job.ID),
+			logger.Error(err),
+		)
+	}
+}
+
+func (uc *ImportOrdersUseCase) process(ctx context.Context, job *domain.ImportJob, rows []dto.ImportOrderRow, afterRow func()) {
+	for _, row := range rows {
+		job.Record(uc.importRow(ctx, job.ClientID, row))
+		if afterRow != nil {
+			afterRow()
+		}
+	}
+}
+
+func (uc *ImportOrdersUseCase) importRow(ctx context.Context, clientID string, row dto.ImportOrderRow) domain.ImportRowResult {
+	result := domain.ImportRowResult{Row: row.Row}
+
+	if row.ParseError != "" {
+		result.Error = row.ParseError
+		return result
+	}
+
+	if err := uc.validator.Validate(row.Request); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	order, err := uc.createOrder.Execute(ctx, clientID, row.Request)
+	if err != nil {
+		var appErr *appErrors.AppError
+		if errors.As(err, &appErr) {
+			result.Error = appErr.Message
+		} else {
+			result.Error = "failed to create order"
+		}
+		return result
+	}
+
+	result.Success = true
+	result.OrderID = order.ID
+	result.TrackingCode = order.TrackingCode
+	return result
+}
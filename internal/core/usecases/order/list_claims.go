@@ -0,0 +1,64 @@
+package order
+
+import (
+	"context"
+	"math"
+
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type ListClaimsUseCase struct {
+	claimRepo repositories.ClaimRepository
+	logger    logger.Logger
+}
+
+func NewListClaimsUseCase(
+	claimRepo repositories.ClaimRepository,
+	logger logger.Logger,
+) *ListClaimsUseCase {
+	return &ListClaimsUseCase{
+		claimRepo: claimRepo,
+		logger:    logger,
+	}
+}
+
+// Execute lists claims newest first for the admin review queue.
+func (uc *ListClaimsUseCase) Execute(ctx context.Context, req dto.ListClaimsRequest) (*dto.ListClaimsResponse, error) {
+	uc.logger.Info("Listing claims", logger.String("status", string(req.Status)))
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 || req.Limit > 100 {
+		req.Limit = 10
+	}
+	offset := (req.Page - 1) * req.Limit
+
+	filter := repositories.ClaimFilter{
+		Status:   req.Status,
+		ClientID: req.ClientID,
+	}
+
+	claims, err := uc.claimRepo.List(ctx, filter, req.Limit, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list claims", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	total, err := uc.claimRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count claims", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	return &dto.ListClaimsResponse{
+		Claims:     dto.ToClaimResponseList(claims),
+		Total:      total,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+	}, nil
+}
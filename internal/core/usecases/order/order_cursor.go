@@ -0,0 +1,38 @@
+package order
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// orderCursorToken is the JSON behind the opaque cursor handed to clients.
+type orderCursorToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func encodeOrderCursor(order *domain.Order) string {
+	data, _ := json.Marshal(orderCursorToken{CreatedAt: order.CreatedAt, ID: order.ID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeOrderCursor(cursor string) (*repositories.OrderCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	var token orderCursorToken
+	if err := json.Unmarshal(data, &token); err != nil || token.ID == "" || token.CreatedAt.IsZero() {
+		return nil, errInvalidCursor
+	}
+
+	return &repositories.OrderCursor{CreatedAt: token.CreatedAt, ID: token.ID}, nil
+}
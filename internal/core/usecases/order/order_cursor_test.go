@@ -0,0 +1,46 @@
+package order
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"logistics-api/internal/core/domain"
+)
+
+func TestOrderCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 10, 12, 10, 30, 15, 123456000, time.UTC)
+	cursor := encodeOrderCursor(&domain.Order{ID: "order-1", CreatedAt: createdAt})
+
+	decoded, err := decodeOrderCursor(cursor)
+	if err != nil {
+		t.Fatalf("decodeOrderCursor() error = %v", err)
+	}
+	if decoded.ID != "order-1" || !decoded.CreatedAt.Equal(createdAt) {
+		t.Errorf("decodeOrderCursor() = %+v, want order-1 at %s", decoded, createdAt)
+	}
+}
+
+func TestDecodeOrderCursorErrors(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "%%%"},
+		{name: "not json", cursor: encode("order-1")},
+		{name: "missing id", cursor: encode(`{"c":"2026-10-12T10:00:00Z"}`)},
+		{name: "missing time", cursor: encode(`{"i":"order-1"}`)},
+		{name: "malformed time", cursor: encode(`{"c":"yesterday","i":"order-1"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeOrderCursor(tt.cursor); !errors.Is(err, errInvalidCursor) {
+				t.Errorf("decodeOrderCursor(%q) error = %v, want %v", tt.cursor, err, errInvalidCursor)
+			}
+		})
+	}
+}
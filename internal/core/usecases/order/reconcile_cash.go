@@ -0,0 +1,116 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+const maxReconciliationDays = 31
+
+type ReconcileCashUseCase struct {
+	orderRepo       repositories.OrderRepository
+	defaultTimeZone string
+	logger          logger.Logger
+}
+
+func NewReconcileCashUseCase(
+	orderRepo repositories.OrderRepository,
+	defaultTimeZone string,
+	logger logger.Logger,
+) *ReconcileCashUseCase {
+	return &ReconcileCashUseCase{
+		orderRepo:       orderRepo,
+		defaultTimeZone: defaultTimeZone,
+		logger:          logger,
+	}
+}
+
+// Execute totals the cash each driver confirmed per day against what the
+// orders expected, listing every order whose amounts differ.
+func (uc *ReconcileCashUseCase) Execute(ctx context.Context, req dto.CashReconciliationRequest) (*dto.CashReconciliationResponse, error) {
+	uc.logger.Info("Reconciling cash on delivery",
+		logger.String("from", req.From),
+		logger.String("to", req.To),
+		logger.String("driver_id", req.DriverID),
+	)
+
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = uc.defaultTimeZone
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "Local" {
+		return nil, appErrors.NewValidationError("time_zone must be a valid IANA time zone")
+	}
+
+	from, err := time.ParseInLocation("2006-01-02", req.From, location)
+	if err != nil {
+		return nil, appErrors.NewValidationError("from must be a date (YYYY-MM-DD)")
+	}
+	to, err := time.ParseInLocation("2006-01-02", req.To, location)
+	if err != nil {
+		return nil, appErrors.NewValidationError("to must be a date (YYYY-MM-DD)")
+	}
+	if to.Before(from) {
+		return nil, appErrors.NewValidationError("to must not be before from")
+	}
+	if to.Sub(from) >= maxReconciliationDays*24*time.Hour {
+		return nil, appErrors.NewValidationError("the range can cover at most 31 days")
+	}
+
+	orders, err := uc.orderRepo.ListCashCollections(ctx, repositories.CashCollectionFilter{
+		CollectedFrom: from,
+		CollectedTo:   to.AddDate(0, 0, 1),
+		DriverID:      req.DriverID,
+	})
+	if err != nil {
+		uc.logger.Error("Failed to list cash collections", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	type dayKey struct {
+		driverID, date, currency string
+	}
+	days := make(map[dayKey]*dto.DriverCashDay)
+	var ordered []*dto.DriverCashDay
+	for _, order := range orders {
+		key := dayKey{
+			driverID: order.CODDriverID,
+			date:     order.CODCollectedAt.In(location).Format("2006-01-02"),
+			currency: order.CODCurrency,
+		}
+		day, ok := days[key]
+		if !ok {
+			day = &dto.DriverCashDay{DriverID: key.driverID, Date: key.date, Currency: key.currency}
+			days[key] = day
+			ordered = append(ordered, day)
+		}
+
+		day.Orders++
+		day.Expected += order.CODAmount
+		day.Collected += *order.CODCollectedAmount
+		if order.CashDiscrepancy() != 0 {
+			day.HasDiscrepancy = true
+			day.Discrepancies = append(day.Discrepancies, dto.ToCashDiscrepancyResponse(order))
+		}
+	}
+
+	for _, day := range ordered {
+		day.Expected = domain.RoundAmount(day.Expected)
+		day.Collected = domain.RoundAmount(day.Collected)
+		day.Difference = domain.RoundAmount(day.Collected - day.Expected)
+	}
+
+	return &dto.CashReconciliationResponse{
+		From:     req.From,
+		To:       req.To,
+		TimeZone: timeZone,
+		Days:     ordered,
+	}, nil
+}
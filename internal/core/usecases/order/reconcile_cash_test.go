@@ -0,0 +1,112 @@
+package order
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+)
+
+func TestReconcileCashGroupsByDriverDayAndCurrency(t *testing.T) {
+	location := mustLoadLocation(t, "America/Mexico_City")
+	collect := func(id, driverID, currency string, expected, collected float64, at time.Time) *domain.Order {
+		return &domain.Order{
+			ID:                 id,
+			CODDriverID:        driverID,
+			CODAmount:          expected,
+			CODCurrency:        currency,
+			CODCollectedAmount: &collected,
+			CODCollectedAt:     &at,
+		}
+	}
+	repo := &cashOrderRepo{orders: []*domain.Order{
+		collect("o1", "driver-1", "MXN", 100.10, 100.10, time.Date(2026, 10, 12, 16, 0, 0, 0, time.UTC)),
+		// 05:30 UTC on the 13th is still the 12th in Mexico City.
+		collect("o2", "driver-1", "MXN", 200.20, 190.20, time.Date(2026, 10, 13, 5, 30, 0, 0, time.UTC)),
+		collect("o3", "driver-1", "USD", 10, 10, time.Date(2026, 10, 12, 18, 0, 0, 0, time.UTC)),
+		collect("o4", "driver-2", "MXN", 50, 50, time.Date(2026, 10, 12, 18, 0, 0, 0, time.UTC)),
+		collect("o5", "driver-1", "MXN", 80, 80, time.Date(2026, 10, 13, 18, 0, 0, 0, time.UTC)),
+	}}
+
+	uc := NewReconcileCashUseCase(repo, "UTC", nopLogger{})
+	response, err := uc.Execute(context.Background(), dto.CashReconciliationRequest{
+		From:     "2026-10-12",
+		To:       "2026-10-13",
+		TimeZone: "America/Mexico_City",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	wantFrom := time.Date(2026, 10, 12, 0, 0, 0, 0, location)
+	if !repo.filter.CollectedFrom.Equal(wantFrom) || !repo.filter.CollectedTo.Equal(wantFrom.AddDate(0, 0, 2)) {
+		t.Errorf("filter = %+v, want local days 12 through 13", repo.filter)
+	}
+
+	want := []dto.DriverCashDay{
+		{DriverID: "driver-1", Date: "2026-10-12", Currency: "MXN", Orders: 2, Expected: 300.30, Collected: 290.30, Difference: -10, HasDiscrepancy: true},
+		{DriverID: "driver-1", Date: "2026-10-12", Currency: "USD", Orders: 1, Expected: 10, Collected: 10},
+		{DriverID: "driver-2", Date: "2026-10-12", Currency: "MXN", Orders: 1, Expected: 50, Collected: 50},
+		{DriverID: "driver-1", Date: "2026-10-13", Currency: "MXN", Orders: 1, Expected: 80, Collected: 80},
+	}
+	if len(response.Days) != len(want) {
+		t.Fatalf("days = %d, want %d", len(response.Days), len(want))
+	}
+	for i, day := range response.Days {
+		w := want[i]
+		if day.DriverID != w.DriverID || day.Date != w.Date || day.Currency != w.Currency || day.Orders != w.Orders ||
+			day.Expected != w.Expected || day.Collected != w.Collected || day.Difference != w.Difference || day.HasDiscrepancy != w.HasDiscrepancy {
+			t.Errorf("days[%d] = %+v, want %+v", i, *day, w)
+		}
+	}
+	if discrepancies := response.Days[0].Discrepancies; len(discrepancies) != 1 || discrepancies[0].OrderID != "o2" {
+		t.Errorf("discrepancies = %+v, want only o2", discrepancies)
+	}
+}
+
+func TestReconcileCashValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  dto.CashReconciliationRequest
+	}{
+		{name: "malformed from", req: dto.CashReconciliationRequest{From: "12/10/2026", To: "2026-10-13"}},
+		{name: "to before from", req: dto.CashReconciliationRequest{From: "2026-10-13", To: "2026-10-12"}},
+		{name: "range too long", req: dto.CashReconciliationRequest{From: "2026-10-01", To: "2026-11-01"}},
+		{name: "unknown time zone", req: dto.CashReconciliationRequest{From: "2026-10-12", To: "2026-10-13", TimeZone: "Mars/Olympus"}},
+		{name: "local time zone", req: dto.CashReconciliationRequest{From: "2026-10-12", To: "2026-10-13", TimeZone: "Local"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewReconcileCashUseCase(&cashOrderRepo{}, "UTC", nopLogger{})
+			_, err := uc.Execute(context.Background(), tt.req)
+			if code := appErrorCode(err); code != http.StatusBadRequest {
+				t.Errorf("Execute() error = %v, want code %d", err, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	return location
+}
+
+type cashOrderRepo struct {
+	repositories.OrderRepository
+	orders []*domain.Order
+	filter repositories.CashCollectionFilter
+}
+
+func (r *cashOrderRepo) ListCashCollections(ctx context.Context, filter repositories.CashCollectionFilter) ([]*domain.Order, error) {
+	r.filter = filter
+	return r.orders, nil
+}
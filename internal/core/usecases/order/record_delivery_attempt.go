@@ -0,0 +1,111 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type RecordDeliveryAttemptUseCase struct {
+	orderRepo   repositories.OrderRepository
+	attemptRepo repositories.DeliveryAttemptRepository
+	eventRepo   repositories.OrderStatusEventRepository
+	txManager   repositories.TransactionManager
+	workflows   services.WorkflowProvider
+	maxAttempts int
+	logger      logger.Logger
+}
+
+func NewRecordDeliveryAttemptUseCase(
+	orderRepo repositories.OrderRepository,
+	attemptRepo repositories.DeliveryAttemptRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	workflows services.WorkflowProvider,
+	maxAttempts int,
+	logger logger.Logger,
+) *RecordDeliveryAttemptUseCase {
+	return &RecordDeliveryAttemptUseCase{
+		orderRepo:   orderRepo,
+		attemptRepo: attemptRepo,
+		eventRepo:   eventRepo,
+		txManager:   txManager,
+		workflows:   workflows,
+		maxAttempts: maxAttempts,
+		logger:      logger,
+	}
+}
+
+func (uc *RecordDeliveryAttemptUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole, req dto.RecordDeliveryAttemptRequest) (*dto.RecordDeliveryAttemptResponse, error) {
+	uc.logger.Info("Recording failed delivery attempt",
+		logger.String("order_id", orderID),
+		logger.String("reason_code", string(req.ReasonCode)),
+	)
+
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Order not found", logger.String("order_id", orderID))
+		return nil, appErrors.NewNotFoundError("order")
+	}
+
+	workflow, err := uc.workflows.GetWorkflow(ctx, order.ServiceType)
+	if err != nil {
+		uc.logger.Error("Failed to resolve workflow",
+			logger.String("service_type", string(order.ServiceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	previousStatus := order.Status
+	attempt, err := order.RecordFailedDelivery(workflow, userRole, userID, req.ReasonCode, req.Notes, uc.maxAttempts)
+	if err != nil {
+		if errors.Is(err, domain.ErrTransitionNotPermitted) {
+			return nil, appErrors.NewForbiddenError()
+		}
+
+		uc.logger.Warn("Failed delivery attempt rejected",
+			logger.String("order_id", orderID),
+			logger.String("current_status", string(order.Status)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	event, err := domain.NewOrderStatusEvent(order.ID, previousStatus, order.Status, userID, string(req.ReasonCode), req.Location)
+	if err != nil {
+		uc.logger.Error("Failed to create status event", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		if err := uc.attemptRepo.Create(ctx, attempt); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to record delivery attempt", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Failed delivery attempt recorded",
+		logger.String("order_id", orderID),
+		logger.Int("attempt_number", attempt.AttemptNumber),
+		logger.String("new_status", string(order.Status)),
+	)
+
+	return &dto.RecordDeliveryAttemptResponse{
+		Attempt: dto.ToDeliveryAttemptResponse(attempt),
+		Order:   dto.ToOrderResponse(order),
+	}, nil
+}
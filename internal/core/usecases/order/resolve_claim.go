@@ -0,0 +1,76 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type ResolveClaimUseCase struct {
+	claimRepo repositories.ClaimRepository
+	logger    logger.Logger
+}
+
+func NewResolveClaimUseCase(
+	claimRepo repositories.ClaimRepository,
+	logger logger.Logger,
+) *ResolveClaimUseCase {
+	return &ResolveClaimUseCase{
+		claimRepo: claimRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *ResolveClaimUseCase) Execute(ctx context.Context, claimID, adminID string, req dto.ResolveClaimRequest) (*dto.ClaimResponse, error) {
+	uc.logger.Info("Resolving claim",
+		logger.String("claim_id", claimID),
+		logger.String("admin_id", adminID),
+		logger.String("status", string(req.Status)),
+	)
+
+	claim, err := uc.claimRepo.GetByID(ctx, claimID)
+	if err != nil {
+		return nil, appErrors.NewNotFoundError("claim")
+	}
+
+	now := time.Now()
+	switch req.Status {
+	case domain.ClaimApproved:
+		if req.ApprovedAmount == nil {
+			return nil, appErrors.NewValidationError("approved_amount is required to approve a claim")
+		}
+		err = claim.Approve(adminID, *req.ApprovedAmount, req.Note, now)
+	case domain.ClaimRejected:
+		err = claim.Reject(adminID, req.Note, now)
+	default:
+		return nil, appErrors.NewValidationError("invalid claim resolution")
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrClaimAlreadyResolved) {
+			return nil, appErrors.NewConflictError(err.Error())
+		}
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if err := uc.claimRepo.Update(ctx, claim); err != nil {
+		if errors.Is(err, domain.ErrClaimAlreadyResolved) {
+			uc.logger.Warn("Claim was resolved concurrently", logger.String("claim_id", claimID))
+			return nil, appErrors.NewConflictError(err.Error())
+		}
+		uc.logger.Error("Failed to update claim", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	uc.logger.Info("Claim resolved successfully",
+		logger.String("claim_id", claimID),
+		logger.String("status", string(claim.Status)),
+	)
+
+	return dto.ToClaimResponse(claim), nil
+}
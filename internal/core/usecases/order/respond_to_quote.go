@@ -0,0 +1,140 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type RespondToQuoteUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	workflows services.WorkflowProvider
+	sla       services.SLACalculator
+	logger    logger.Logger
+}
+
+func NewRespondToQuoteUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	workflows services.WorkflowProvider,
+	sla services.SLACalculator,
+	logger logger.Logger,
+) *RespondToQuoteUseCase {
+	return &RespondToQuoteUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		workflows: workflows,
+		sla:       sla,
+		logger:    logger,
+	}
+}
+
+func (uc *RespondToQuoteUseCase) Accept(ctx context.Context, orderID, clientID string) (*dto.OrderResponse, error) {
+	uc.logger.Info("Accepting quote",
+		logger.String("order_id", orderID),
+		logger.String("client_id", clientID),
+	)
+
+	return uc.respond(ctx, orderID, clientID, "quote_accepted", func(order *domain.Order, workflow *domain.Workflow) error {
+		if err := order.AcceptQuote(workflow); err != nil {
+			return err
+		}
+
+		// Special orders get their promise once the quote is accepted; a failure
+		// here shouldn't block the acceptance itself.
+		promisedAt, err := uc.sla.PromisedDeliveryAt(ctx, order)
+		if err != nil {
+			uc.logger.Warn("Failed to compute delivery promise",
+				logger.String("order_id", order.ID),
+				logger.Error(err),
+			)
+			return nil
+		}
+		order.PromisedDeliveryAt = &promisedAt
+		return nil
+	})
+}
+
+func (uc *RespondToQuoteUseCase) Reject(ctx context.Context, orderID, clientID string, req dto.RejectQuoteRequest) (*dto.OrderResponse, error) {
+	uc.logger.Info("Rejecting quote",
+		logger.String("order_id", orderID),
+		logger.String("client_id", clientID),
+	)
+
+	reason := "quote_rejected"
+	if req.Reason != "" {
+		reason += ": " + req.Reason
+	}
+
+	return uc.respond(ctx, orderID, clientID, reason, func(order *domain.Order, workflow *domain.Workflow) error {
+		return order.RejectQuote(workflow)
+	})
+}
+
+func (uc *RespondToQuoteUseCase) respond(ctx context.Context, orderID, clientID, reason string, apply func(*domain.Order, *domain.Workflow) error) (*dto.OrderResponse, error) {
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, clientID, domain.ClientRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to client",
+			logger.String("order_id", orderID),
+			logger.String("client_id", clientID),
+		)
+		return nil, err
+	}
+
+	workflow, err := uc.workflows.GetWorkflow(ctx, order.ServiceType)
+	if err != nil {
+		uc.logger.Error("Failed to resolve workflow",
+			logger.String("service_type", string(order.ServiceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	previousStatus := order.Status
+	if err := apply(order, workflow); err != nil {
+		if errors.Is(err, domain.ErrTransitionNotPermitted) {
+			return nil, appErrors.NewForbiddenError()
+		}
+
+		uc.logger.Warn("Quote response rejected",
+			logger.String("order_id", orderID),
+			logger.String("current_status", string(order.Status)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	event, err := domain.NewOrderStatusEvent(order.ID, previousStatus, order.Status, clientID, reason, "")
+	if err != nil {
+		uc.logger.Error("Failed to create status event", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to save quote response", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Quote response saved",
+		logger.String("order_id", orderID),
+		logger.String("new_status", string(order.Status)),
+	)
+
+	return dto.ToOrderResponse(order), nil
+}
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type RestoreOrderUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	logger    logger.Logger
+}
+
+func NewRestoreOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	logger logger.Logger,
+) *RestoreOrderUseCase {
+	return &RestoreOrderUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		logger:    logger,
+	}
+}
+
+func (uc *RestoreOrderUseCase) Execute(ctx context.Context, orderID, adminID string) (*dto.OrderResponse, error) {
+	uc.logger.Info("Restoring order",
+		logger.String("order_id", orderID),
+		logger.String("admin_id", adminID),
+	)
+
+	order, err := uc.orderRepo.GetDeletedByID(ctx, orderID)
+	if err != nil {
+		uc.logger.Warn("Deleted order not found", logger.String("order_id", orderID))
+		return nil, appErrors.NewNotFoundError("deleted order")
+	}
+
+	if err := order.Restore(); err != nil {
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	event, err := domain.NewOrderActionEvent(order, domain.OrderEventRestored, adminID, "")
+	if err != nil {
+		uc.logger.Error("Failed to create restore event", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to restore order", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Order restored successfully",
+		logger.String("order_id", orderID),
+		logger.String("admin_id", adminID),
+	)
+
+	return dto.ToOrderResponse(order), nil
+}
@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type UpdateOrderUseCase struct {
+	orderRepo         repositories.OrderRepository
+	eventRepo         repositories.OrderStatusEventRepository
+	txManager         repositories.TransactionManager
+	coordService      services.CoordinateService
+	pricing           services.PricingService
+	sla               services.SLACalculator
+	volumetricDivisor float64
+	logger            logger.Logger
+}
+
+func NewUpdateOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	coordService services.CoordinateService,
+	pricing services.PricingService,
+	sla services.SLACalculator,
+	volumetricDivisor float64,
+	logger logger.Logger,
+) *UpdateOrderUseCase {
+	return &UpdateOrderUseCase{
+		orderRepo:         orderRepo,
+		eventRepo:         eventRepo,
+		txManager:         txManager,
+		coordService:      coordService,
+		pricing:           pricing,
+		sla:               sla,
+		volumetricDivisor: volumetricDivisor,
+		logger:            logger,
+	}
+}
+
+// Execute edits a pending order. expectedVersion is the version the caller
+// last read; a mismatch means someone else changed the order in between.
+func (uc *UpdateOrderUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole, expectedVersion int, req dto.UpdateOrderRequest) (*dto.OrderResponse, error) {
+	uc.logger.Info("Editing order",
+		logger.String("order_id", orderID),
+		logger.String("user_id", userID),
+		logger.Int("expected_version", expectedVersion),
+	)
+
+	order, err := loadVisibleOrder(ctx, uc.orderRepo, orderID, userID, userRole)
+	if err != nil {
+		uc.logger.Warn("Order not available to user",
+			logger.String("order_id", orderID),
+			logger.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	if order.Version != expectedVersion {
+		uc.logger.Warn("Order version mismatch",
+			logger.String("order_id", orderID),
+			logger.Int("current_version", order.Version),
+		)
+		return nil, appErrors.NewConflictError(domain.ErrVersionConflict.Error())
+	}
+
+	if err := uc.validateCoordinates(ctx, req); err != nil {
+		return nil, err
+	}
+
+	edit := dto.ToOrderEdit(req)
+	reprice, err := order.ApplyEdit(edit, uc.volumetricDivisor)
+	if err != nil {
+		uc.logger.Warn("Order cannot be edited",
+			logger.String("order_id", orderID),
+			logger.String("current_status", string(order.Status)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if reprice {
+		quote, err := uc.pricing.Quote(ctx, order.OriginCoords, order.DestinationCoords, order.PackageSize, order.ServiceType)
+		if err != nil {
+			return nil, pricingError(uc.logger, err)
+		}
+		// The edit changed what was quoted, so the order now pays current rates.
+		order.QuoteID = ""
+		if err := order.ApplyQuote(quote); err != nil {
+			uc.logger.Warn("Declared value does not match the price currency", logger.Error(err))
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+
+		promisedAt, err := uc.sla.PromisedDeliveryAt(ctx, order)
+		if err != nil {
+			uc.logger.Error("Failed to compute delivery promise", logger.Error(err))
+			return nil, appErrors.NewInternalError()
+		}
+		order.PromisedDeliveryAt = &promisedAt
+	}
+
+	event, err := domain.NewOrderActionEvent(order, domain.OrderEventEdited, userID, strings.Join(edit.ChangedFields(), ", "))
+	if err != nil {
+		uc.logger.Error("Failed to create edit event", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to edit order", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Order edited successfully",
+		logger.String("order_id", orderID),
+		logger.Int("version", order.Version),
+	)
+
+	return dto.ToOrderResponse(order), nil
+}
+
+// validateCoordinates checks the new coordinates that come with an address
+// change.
+func (uc *UpdateOrderUseCase) validateCoordinates(ctx context.Context, req dto.UpdateOrderRequest) error {
+	if req.OriginCoordinates != nil {
+		if err := uc.coordService.ValidateCoordinates(ctx, *req.OriginCoordinates); err != nil {
+			uc.logger.Warn("Invalid origin coordinates", logger.Error(err))
+			return appErrors.NewValidationError("invalid origin coordinates")
+		}
+	}
+
+	if req.DestinationCoordinates != nil {
+		if err := uc.coordService.ValidateCoordinates(ctx, *req.DestinationCoordinates); err != nil {
+			uc.logger.Warn("Invalid destination coordinates", logger.Error(err))
+			return appErrors.NewValidationError("invalid destination coordinates")
+		}
+	}
+
+	return nil
+}
+
+// saveError maps a failed order write to the error returned to the caller.
+func saveError(err error) error {
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return appErrors.NewConflictError(err.Error())
+	}
+	return appErrors.NewInternalError()
+}
+
+// pricingError maps a failed price lookup. A service type without a rate
+// table is reported to the caller instead of failing as a server error.
+func pricingError(log logger.Logger, err error) error {
+	if errors.Is(err, domain.ErrNoRateTable) {
+		log.Warn("No rate table for service type", logger.Error(err))
+		return appErrors.NewValidationError(err.Error())
+	}
+	log.Error("Failed to price order", logger.Error(err))
+	return appErrors.NewInternalError()
+}
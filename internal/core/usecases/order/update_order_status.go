@@ -2,8 +2,11 @@ package order
 
 import (
 	"context"
+	"errors"
+
 	"logistics-api/internal/core/domain"
 	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
 	"logistics-api/internal/core/usecases/dto"
 	appErrors "logistics-api/internal/pkg/errors"
 	"logistics-api/internal/pkg/logger"
@@ -11,41 +14,60 @@ import (
 
 type UpdateOrderStatusUseCase struct {
 	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	workflows services.WorkflowProvider
 	logger    logger.Logger
 }
 
 func NewUpdateOrderStatusUseCase(
 	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	workflows services.WorkflowProvider,
 	logger logger.Logger,
 ) *UpdateOrderStatusUseCase {
 	return &UpdateOrderStatusUseCase{
 		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		workflows: workflows,
 		logger:    logger,
 	}
 }
 
-func (uc *UpdateOrderStatusUseCase) Execute(ctx context.Context, orderID string, userRole domain.UserRole, req dto.UpdateOrderStatusRequest) (*dto.OrderResponse, error) {
+func (uc *UpdateOrderStatusUseCase) Execute(ctx context.Context, orderID, userID string, userRole domain.UserRole, req dto.UpdateOrderStatusRequest) (*dto.OrderResponse, error) {
 	uc.logger.Info("Updating order status",
 		logger.String("order_id", orderID),
 		logger.String("new_status", string(req.Status)),
 		logger.String("user_role", string(userRole)),
 	)
 
-	if userRole != domain.AdminRole {
-		uc.logger.Warn("Unauthorized attempt to update order status",
-			logger.String("order_id", orderID),
-			logger.String("user_role", string(userRole)),
-		)
-		return nil, appErrors.NewForbiddenError()
-	}
-
 	order, err := uc.orderRepo.GetByID(ctx, orderID)
 	if err != nil {
 		uc.logger.Error("Order not found", logger.String("order_id", orderID))
 		return nil, appErrors.NewNotFoundError("order")
 	}
 
-	if err := order.UpdateStatus(req.Status); err != nil {
+	workflow, err := uc.workflows.GetWorkflow(ctx, order.ServiceType)
+	if err != nil {
+		uc.logger.Error("Failed to resolve workflow",
+			logger.String("service_type", string(order.ServiceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	previousStatus := order.Status
+	if err := order.TransitionTo(workflow, req.Status, userRole); err != nil {
+		if errors.Is(err, domain.ErrTransitionNotPermitted) {
+			uc.logger.Warn("Unauthorized attempt to update order status",
+				logger.String("order_id", orderID),
+				logger.String("user_role", string(userRole)),
+			)
+			return nil, appErrors.NewForbiddenError()
+		}
+
 		uc.logger.Warn("Invalid status transition",
 			logger.String("order_id", orderID),
 			logger.String("current_status", string(order.Status)),
@@ -55,9 +77,21 @@ func (uc *UpdateOrderStatusUseCase) Execute(ctx context.Context, orderID string,
 		return nil, appErrors.NewValidationError(err.Error())
 	}
 
-	if err := uc.orderRepo.Update(ctx, order); err != nil {
+	event, err := domain.NewOrderStatusEvent(order.ID, previousStatus, order.Status, userID, req.Reason, req.Location)
+	if err != nil {
+		uc.logger.Error("Failed to create status event", logger.Error(err))
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
 		uc.logger.Error("Failed to update order", logger.Error(err))
-		return nil, appErrors.NewInternalError()
+		return nil, saveError(err)
 	}
 
 	uc.logger.Info("Order status updated successfully",
@@ -0,0 +1,113 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type UpdateParcelStatusUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	txManager repositories.TransactionManager
+	workflows services.WorkflowProvider
+	logger    logger.Logger
+}
+
+func NewUpdateParcelStatusUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	txManager repositories.TransactionManager,
+	workflows services.WorkflowProvider,
+	logger logger.Logger,
+) *UpdateParcelStatusUseCase {
+	return &UpdateParcelStatusUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		txManager: txManager,
+		workflows: workflows,
+		logger:    logger,
+	}
+}
+
+func (uc *UpdateParcelStatusUseCase) Execute(ctx context.Context, orderID, parcelID, userID string, userRole domain.UserRole, req dto.UpdateParcelStatusRequest) (*dto.OrderResponse, error) {
+	uc.logger.Info("Updating parcel status",
+		logger.String("order_id", orderID),
+		logger.String("parcel_id", parcelID),
+		logger.String("new_status", string(req.Status)),
+	)
+
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		uc.logger.Error("Order not found", logger.String("order_id", orderID))
+		return nil, appErrors.NewNotFoundError("order")
+	}
+
+	if order.FindParcel(parcelID) == nil {
+		uc.logger.Warn("Parcel not found",
+			logger.String("order_id", orderID),
+			logger.String("parcel_id", parcelID),
+		)
+		return nil, appErrors.NewNotFoundError("parcel")
+	}
+
+	workflow, err := uc.workflows.GetWorkflow(ctx, order.ServiceType)
+	if err != nil {
+		uc.logger.Error("Failed to resolve workflow",
+			logger.String("service_type", string(order.ServiceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewInternalError()
+	}
+
+	previousStatus := order.Status
+	if err := order.UpdateParcelStatus(workflow, parcelID, req.Status, userRole); err != nil {
+		if errors.Is(err, domain.ErrTransitionNotPermitted) {
+			return nil, appErrors.NewForbiddenError()
+		}
+
+		uc.logger.Warn("Invalid parcel status transition",
+			logger.String("order_id", orderID),
+			logger.String("parcel_id", parcelID),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	var event *domain.OrderStatusEvent
+	if order.Status != previousStatus {
+		event, err = domain.NewOrderStatusEvent(order.ID, previousStatus, order.Status, userID, "parcel_status_rollup", req.Location)
+		if err != nil {
+			uc.logger.Error("Failed to create status event", logger.Error(err))
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+	}
+
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		if event == nil {
+			return nil
+		}
+		return uc.eventRepo.Create(ctx, event)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update parcel status", logger.Error(err))
+		return nil, saveError(err)
+	}
+
+	uc.logger.Info("Parcel status updated successfully",
+		logger.String("order_id", orderID),
+		logger.String("parcel_id", parcelID),
+		logger.String("order_status", string(order.Status)),
+	)
+
+	return dto.ToOrderResponse(order), nil
+}
@@ -0,0 +1,109 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type GetQuoteUseCase struct {
+	quoteRepo    repositories.ShipmentQuoteRepository
+	coordService services.CoordinateService
+	pricing      services.PricingService
+	insurance    domain.InsurancePolicy
+	ttl          time.Duration
+	logger       logger.Logger
+}
+
+func NewGetQuoteUseCase(
+	quoteRepo repositories.ShipmentQuoteRepository,
+	coordService services.CoordinateService,
+	pricing services.PricingService,
+	insurance domain.InsurancePolicy,
+	ttl time.Duration,
+	logger logger.Logger,
+) *GetQuoteUseCase {
+	return &GetQuoteUseCase{
+		quoteRepo:    quoteRepo,
+		coordService: coordService,
+		pricing:      pricing,
+		insurance:    insurance,
+		ttl:          ttl,
+		logger:       logger,
+	}
+}
+
+// Execute prices the shipment and stores the quote, so an order created with
+// its quote_id before it expires keeps this price.
+func (uc *GetQuoteUseCase) Execute(ctx context.Context, clientID string, req dto.QuoteRequest) (*dto.QuoteResponse, error) {
+	serviceType := req.ServiceType
+	if serviceType == "" {
+		serviceType = domain.ServiceTypeStandard
+	}
+
+	uc.logger.Info("Quoting shipment",
+		logger.String("package_size", string(req.PackageSize)),
+		logger.String("service_type", string(serviceType)),
+	)
+
+	if req.Insured && req.DeclaredValue == nil {
+		return nil, appErrors.NewValidationError("insurance requires a declared value")
+	}
+
+	if err := uc.coordService.ValidateCoordinates(ctx, req.OriginCoordinates); err != nil {
+		uc.logger.Warn("Invalid origin coordinates", logger.Error(err))
+		return nil, appErrors.NewValidationError("invalid origin coordinates")
+	}
+
+	if err := uc.coordService.ValidateCoordinates(ctx, req.DestinationCoordinates); err != nil {
+		uc.logger.Warn("Invalid destination coordinates", logger.Error(err))
+		return nil, appErrors.NewValidationError("invalid destination coordinates")
+	}
+
+	quote, err := uc.pricing.Quote(ctx, req.OriginCoordinates, req.DestinationCoordinates, req.PackageSize, serviceType)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoRateTable) {
+			uc.logger.Warn("No rate table for service type", logger.Error(err))
+			return nil, appErrors.NewValidationError(err.Error())
+		}
+		uc.logger.Error("Failed to price shipment", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	stored := domain.NewShipmentQuote(clientID, req.OriginCoordinates, req.DestinationCoordinates, quote, time.Now(), uc.ttl)
+	if err := uc.quoteRepo.Create(ctx, stored); err != nil {
+		uc.logger.Error("Failed to save quote", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	if req.Insured {
+		coverage, premium := uc.insurance.Cover(*req.DeclaredValue)
+		quote.AddInsurance(coverage, premium)
+	}
+
+	return dto.ToQuoteResponse(stored, quote), nil
+}
+
+// StartCleanup periodically removes expired quotes.
+func (uc *GetQuoteUseCase) StartCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			count, err := uc.quoteRepo.DeleteExpired(context.Background(), time.Now())
+			if err != nil {
+				uc.logger.Error("Failed to delete expired quotes", logger.Error(err))
+				continue
+			}
+			if count > 0 {
+				uc.logger.Info("Deleted expired quotes", logger.Int("count", int(count)))
+			}
+		}
+	}()
+}
@@ -0,0 +1,58 @@
+package tracking
+
+import (
+	"context"
+
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/repositories"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type TrackOrderUseCase struct {
+	orderRepo repositories.OrderRepository
+	eventRepo repositories.OrderStatusEventRepository
+	logger    logger.Logger
+}
+
+func NewTrackOrderUseCase(
+	orderRepo repositories.OrderRepository,
+	eventRepo repositories.OrderStatusEventRepository,
+	logger logger.Logger,
+) *TrackOrderUseCase {
+	return &TrackOrderUseCase{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+		logger:    logger,
+	}
+}
+
+func (uc *TrackOrderUseCase) Execute(ctx context.Context, code string) (*dto.TrackingResponse, error) {
+	code = domain.NormalizeTrackingCode(code)
+	uc.logger.Info("Tracking order", logger.String("tracking_code", code))
+
+	// Codes with a bad check character never reach the database. A parcel
+	// code leads to its order, which lists the status of every parcel.
+	orderCode := code
+	if parcelOrderCode, ok := domain.OrderCodeFromParcelCode(code); ok {
+		orderCode = parcelOrderCode
+	} else if !domain.IsValidTrackingCode(code) {
+		uc.logger.Warn("Invalid tracking code", logger.String("tracking_code", code))
+		return nil, appErrors.NewNotFoundError("shipment")
+	}
+
+	order, err := uc.orderRepo.GetByTrackingCode(ctx, orderCode)
+	if err != nil {
+		uc.logger.Warn("Tracking code not found", logger.String("tracking_code", code))
+		return nil, appErrors.NewNotFoundError("shipment")
+	}
+
+	events, err := uc.eventRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		uc.logger.Error("Failed to get order history", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	return dto.ToTrackingResponse(order, events), nil
+}
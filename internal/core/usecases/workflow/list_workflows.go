@@ -0,0 +1,36 @@
+package workflow
+
+import (
+	"context"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type ListWorkflowsUseCase struct {
+	workflows services.WorkflowProvider
+	logger    logger.Logger
+}
+
+func NewListWorkflowsUseCase(
+	workflows services.WorkflowProvider,
+	logger logger.Logger,
+) *ListWorkflowsUseCase {
+	return &ListWorkflowsUseCase{
+		workflows: workflows,
+		logger:    logger,
+	}
+}
+
+func (uc *ListWorkflowsUseCase) Execute(ctx context.Context) ([]*dto.WorkflowResponse, error) {
+	uc.logger.Info("Listing order workflows")
+
+	workflows, err := uc.workflows.GetWorkflows(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to load workflows", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	return dto.ToWorkflowResponseList(workflows), nil
+}
@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"context"
+	"logistics-api/internal/core/domain"
+	"logistics-api/internal/core/ports/services"
+	"logistics-api/internal/core/usecases/dto"
+	appErrors "logistics-api/internal/pkg/errors"
+	"logistics-api/internal/pkg/logger"
+)
+
+type SaveWorkflowUseCase struct {
+	workflows services.WorkflowProvider
+	logger    logger.Logger
+}
+
+func NewSaveWorkflowUseCase(
+	workflows services.WorkflowProvider,
+	logger logger.Logger,
+) *SaveWorkflowUseCase {
+	return &SaveWorkflowUseCase{
+		workflows: workflows,
+		logger:    logger,
+	}
+}
+
+func (uc *SaveWorkflowUseCase) Execute(ctx context.Context, serviceType domain.ServiceType, req dto.SaveWorkflowRequest) (*dto.WorkflowResponse, error) {
+	uc.logger.Info("Saving order workflow", logger.String("service_type", string(serviceType)))
+
+	workflow := dto.ToWorkflow(serviceType, req)
+	if err := workflow.Validate(); err != nil {
+		uc.logger.Warn("Invalid workflow definition",
+			logger.String("service_type", string(serviceType)),
+			logger.Error(err),
+		)
+		return nil, appErrors.NewValidationError(err.Error())
+	}
+
+	if err := uc.workflows.SaveWorkflow(ctx, workflow); err != nil {
+		uc.logger.Error("Failed to save workflow", logger.Error(err))
+		return nil, appErrors.NewInternalError()
+	}
+
+	uc.logger.Info("Order workflow saved successfully", logger.String("service_type", string(serviceType)))
+
+	return dto.ToWorkflowResponse(workflow), nil
+}
@@ -48,6 +48,22 @@ func NewForbiddenError() *AppError {
 	}
 }
 
+func NewConflictError(message string) *AppError {
+	return &AppError{
+		Code:    http.StatusConflict,
+		Message: message,
+		Type:    "conflict_error",
+	}
+}
+
+func NewServiceUnavailableError(message string) *AppError {
+	return &AppError{
+		Code:    http.StatusServiceUnavailable,
+		Message: message,
+		Type:    "service_unavailable_error",
+	}
+}
+
 func NewInternalError() *AppError {
 	return &AppError{
 		Code:    http.StatusInternalServerError,